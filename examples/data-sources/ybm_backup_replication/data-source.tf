@@ -0,0 +1,17 @@
+data "ybm_backup_replication" "example" {
+  cluster_id = "example-cluster-id"
+}
+
+output "next_transfer" {
+  value = data.ybm_backup_replication.example.gcp_spec.sync_cluster_spec.replication_config.next_transfer_operation_time
+}
+
+# Fail the monitoring run when a backup transfer of the cluster failed
+resource "terraform_data" "backup_replication_check" {
+  lifecycle {
+    postcondition {
+      condition     = !data.ybm_backup_replication.example.has_failed_transfers
+      error_message = "A backup transfer of the cluster failed."
+    }
+  }
+}
@@ -0,0 +1,29 @@
+# The successful on demand backups of a cluster taken in January 2024
+data "ybm_backups" "january" {
+  cluster_id     = "example-cluster-id"
+  state          = "SUCCEEDED"
+  backup_type    = "ON_DEMAND"
+  created_after  = "2024-01-01T00:00:00Z"
+  created_before = "2024-01-31T23:59:59Z"
+}
+
+# The failed backups of every cluster of the project, for an audit report
+data "ybm_backups" "failed" {
+  state = "FAILED"
+}
+
+output "january_backup_sizes" {
+  value = { for backup in data.ybm_backups.january.backups : backup.backup_id => backup.size_in_bytes }
+}
+
+# Restore the most recent pre-release backup
+data "ybm_backups" "pre_release" {
+  cluster_id           = "example-cluster-id"
+  state                = "SUCCEEDED"
+  description_contains = "pre-release"
+}
+
+resource "ybm_backup_restore" "rollback" {
+  backup_id         = data.ybm_backups.pre_release.backups[0].backup_id
+  target_cluster_id = "example-cluster-id"
+}
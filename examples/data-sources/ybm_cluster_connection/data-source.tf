@@ -0,0 +1,28 @@
+data "ybm_cluster_connection" "example" {
+  cluster_id = ybm_cluster.single_region_cluster.cluster_id
+  username   = "admin"
+  # database           = "yugabyte" #Optional, defaults to yugabyte
+  # ssl_root_cert_path = "root.crt" #Optional, defaults to root.crt
+}
+
+# Save the CA certificate where the connection strings expect it
+resource "local_file" "root_crt" {
+  content  = data.ybm_cluster_connection.example.ca_certificate
+  filename = "root.crt"
+}
+
+locals {
+  public_connection = [for c in data.ybm_cluster_connection.example.connections : c if c.accessibility_type == "PUBLIC"][0]
+}
+
+output "ysql_uri" {
+  value = "${local.public_connection.ysql_uri}&${local.public_connection.load_balance_params}"
+}
+
+output "jdbc_url" {
+  value = "${local.public_connection.jdbc_url}&${local.public_connection.jdbc_load_balance_params}"
+}
+
+output "ycql_contact_point" {
+  value = local.public_connection.ycql_contact_point
+}
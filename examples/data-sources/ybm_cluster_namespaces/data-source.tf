@@ -0,0 +1,14 @@
+data "ybm_cluster_namespaces" "databases" {
+  cluster_id     = "example-cluster-id"
+  namespace_type = "YSQL"
+}
+
+# Enable PITR on every YSQL database of the cluster
+resource "ybm_pitr_config" "all_databases" {
+  for_each = toset(data.ybm_cluster_namespaces.databases.ysql_databases)
+
+  cluster_id               = "example-cluster-id"
+  namespace_name           = each.value
+  namespace_type           = "YSQL"
+  retention_period_in_days = 7
+}
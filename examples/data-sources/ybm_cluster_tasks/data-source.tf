@@ -0,0 +1,26 @@
+# The 20 most recent tasks of the cluster
+data "ybm_cluster_tasks" "recent" {
+  cluster_id = "cluster-id"
+}
+
+# The 50 most recent failed edits of the cluster
+data "ybm_cluster_tasks" "failed_edits" {
+  cluster_id = "cluster-id"
+  task_type  = "EDIT_CLUSTER"
+  state      = "FAILED"
+  limit      = 50
+}
+
+output "failure_reasons" {
+  value = [for task in data.ybm_cluster_tasks.failed_edits.tasks : "${task.start_time}: ${task.error_message}"]
+}
+
+# Block the deployment while YugabyteDB Aeon is still working on the cluster
+resource "terraform_data" "deployment_gate" {
+  lifecycle {
+    precondition {
+      condition     = !data.ybm_cluster_tasks.recent.has_running_tasks
+      error_message = "The cluster has running tasks, try again once they complete."
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# All the clusters of the project
+data "ybm_clusters" "all" {}
+
+# Active paid AWS clusters on the Extended track whose name starts with "prod-"
+data "ybm_clusters" "production" {
+  name_regex     = "^prod-"
+  cloud_type     = "AWS"
+  cluster_tier   = "PAID"
+  state          = "ACTIVE"
+  region         = "us-west-2"
+  database_track = "Extended"
+}
+
+# Associate a metrics exporter with every matching cluster
+resource "ybm_associate_metrics_exporter_cluster" "fleet" {
+  for_each   = { for cluster in data.ybm_clusters.production.clusters : cluster.cluster_name => cluster }
+  cluster_id = each.value.cluster_id
+  config_id  = ybm_integration.datadog.config_id
+}
@@ -0,0 +1,15 @@
+# All the database tracks available to the account
+data "ybm_database_tracks" "all" {}
+
+# The Extended track, also accepted under its deprecated name Production
+data "ybm_database_tracks" "extended" {
+  name = "Production"
+}
+
+output "extended_latest_release" {
+  value = data.ybm_database_tracks.extended.tracks[0].latest_release_version
+}
+
+output "extended_default_release" {
+  value = one([for release in data.ybm_database_tracks.extended.tracks[0].releases : release.version if release.is_default])
+}
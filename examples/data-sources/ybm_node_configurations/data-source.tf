@@ -0,0 +1,38 @@
+variable "password" {
+  type        = string
+  description = "YSQL and YCQL Password."
+  sensitive   = true
+}
+
+# The node configurations in us-west-2 with at least 16 GB of memory, smallest first
+data "ybm_node_configurations" "at_least_16gb" {
+  cloud_type    = "AWS"
+  regions       = ["us-west-2"]
+  min_memory_mb = 16384
+}
+
+locals {
+  smallest_node = data.ybm_node_configurations.at_least_16gb.node_configurations[0]
+}
+
+resource "ybm_cluster" "single_region_cluster" {
+  cluster_name = "single-region-cluster"
+  cloud_type   = "AWS"
+  cluster_type = "SYNCHRONOUS"
+  cluster_region_info = {
+    "us-west-2" = {
+      num_nodes = 3
+    }
+  }
+  cluster_tier    = "PAID"
+  fault_tolerance = "ZONE"
+  node_config = {
+    num_cores    = local.smallest_node.num_cores
+    disk_size_gb = local.smallest_node.included_disk_size_gb
+    disk_iops    = local.smallest_node.min_disk_iops
+  }
+  credentials = {
+    username = "example_user"
+    password = var.password
+  }
+}
@@ -0,0 +1,20 @@
+# All the GCP regions available for dedicated clusters
+data "ybm_regions" "gcp" {
+  cloud_type = "GCP"
+}
+
+# The AWS regions in the United States
+data "ybm_regions" "aws_us" {
+  cloud_type   = "AWS"
+  country_code = "US"
+}
+
+# The Azure regions whose code or name mentions Europe
+data "ybm_regions" "azure_europe" {
+  cloud_type = "AZURE"
+  name_regex = "(?i)europe"
+}
+
+output "aws_us_region_codes" {
+  value = [for region in data.ybm_regions.aws_us.regions : region.code]
+}
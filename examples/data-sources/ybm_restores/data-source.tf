@@ -0,0 +1,33 @@
+# The restores onto a cluster, most recent first
+data "ybm_restores" "dr_target" {
+  cluster_id = "example-cluster-id"
+}
+
+# The failed restores of a backup
+data "ybm_restores" "failed" {
+  backup_id = "example-backup-id"
+  state     = "FAILED"
+}
+
+output "restore_failures" {
+  value = [for restore in data.ybm_restores.failed.restores : "${restore.start_time}: ${restore.error_message}"]
+}
+
+# Fail the DR drill unless the last restore succeeded within one hour
+data "ybm_restores" "last_drill" {
+  cluster_id = "example-cluster-id"
+  limit      = 1
+}
+
+resource "terraform_data" "dr_drill_sla" {
+  lifecycle {
+    precondition {
+      condition = try(
+        data.ybm_restores.last_drill.restores[0].state == "SUCCEEDED" &&
+        data.ybm_restores.last_drill.restores[0].duration_in_secs <= 3600,
+        false
+      )
+      error_message = "The last restore did not succeed within one hour."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "ybm_vpc_peering" "example_peering" {
+  name = "example-peering"
+}
+
+# Accept the peering connection on the AWS side
+resource "aws_vpc_peering_connection_accepter" "yugabytedb" {
+  vpc_peering_connection_id = data.ybm_vpc_peering.example_peering.peering_connection_id
+  auto_accept               = true
+}
@@ -0,0 +1,12 @@
+# The peerings of a VPC still waiting for the application side
+data "ybm_vpc_peerings" "pending" {
+  yugabytedb_vpc_id = "example-vpc-id"
+  cloud             = "AWS"
+  state             = "PENDING"
+}
+
+resource "aws_vpc_peering_connection_accepter" "yugabytedb" {
+  for_each                  = { for peering in data.ybm_vpc_peerings.pending.vpc_peerings : peering.name => peering }
+  vpc_peering_connection_id = each.value.peering_connection_id
+  auto_accept               = true
+}
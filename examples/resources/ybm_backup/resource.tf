@@ -3,3 +3,11 @@ resource "ybm_backup" "example_backup" {
   backup_description       = "example-backup-description"
   retention_period_in_days = 2
 }
+
+# Compliance backup kept for a year, even after this workspace is destroyed
+resource "ybm_backup" "compliance_backup" {
+  cluster_id               = "example-cluster-id"
+  backup_description       = "Year end compliance backup"
+  retention_period_in_days = 365
+  retain_on_destroy        = true
+}
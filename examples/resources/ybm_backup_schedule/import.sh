@@ -0,0 +1,5 @@
+# Backup schedule can be imported using the schedule id and cluster id.
+# Schedule Id and Cluster Id need to be separated with ,
+
+# Example:
+terraform import ybm_backup_schedule.my_backup_schedule "schedule-id,cluster-id"
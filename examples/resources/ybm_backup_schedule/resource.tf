@@ -0,0 +1,17 @@
+# Daily full backups kept for a week, with an incremental backup every hour
+resource "ybm_backup_schedule" "daily" {
+  cluster_id                   = "example-cluster-id"
+  cron_expression              = "0 2 * * *"
+  retention_period_in_days     = 7
+  incremental_interval_in_mins = 60
+  backup_description           = "Daily backup"
+}
+
+# Weekly full backups kept for three months, paused for now
+resource "ybm_backup_schedule" "weekly" {
+  cluster_id               = "example-cluster-id"
+  time_interval_in_days    = 7
+  retention_period_in_days = 90
+  backup_description       = "Weekly backup"
+  state                    = "PAUSED"
+}
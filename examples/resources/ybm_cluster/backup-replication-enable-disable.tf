@@ -18,14 +18,13 @@ resource "ybm_cluster" "cluster_with_backup_replication_disabled" {
   cluster_type = "SYNCHRONOUS"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 3
       vpc_id    = "example-vpc-id"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "ZONE"
@@ -63,14 +62,13 @@ resource "ybm_cluster" "cluster_with_backup_replication_enabled" {
   cluster_type = "SYNCHRONOUS"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 3
       vpc_id    = "example-vpc-id"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "ZONE"
@@ -111,14 +109,13 @@ resource "ybm_cluster" "cluster_without_backup_replication" {
   cluster_type = "SYNCHRONOUS"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 3
       vpc_id    = "example-vpc-id"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "ZONE"
@@ -12,9 +12,8 @@ resource "ybm_cluster" "single_region_backup_replication" {
   cluster_name = "single-region-backup-replication"
   cloud_type   = "GCP"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region                        = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
@@ -22,7 +21,7 @@ resource "ybm_cluster" "single_region_backup_replication" {
       public_access                 = true
       backup_replication_gcp_target = "single-region-backup-bucket"
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "NONE"
@@ -47,27 +46,24 @@ resource "ybm_cluster" "multi_region_sync_centralized_backup" {
   cluster_name = "multi-region-sync-centralized-backup"
   cloud_type   = "GCP"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region                        = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
       vpc_id                        = "example-vpc-id-1"
       public_access                 = true
       backup_replication_gcp_target = "central-backup-bucket" # Same for all regions
-    },
-    {
-      region                        = "us-central1"
+    }
+    "us-central1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
       vpc_id                        = "example-vpc-id-2"
       public_access                 = true
       backup_replication_gcp_target = "central-backup-bucket" # Same for all regions
-    },
-    {
-      region                        = "us-east1"
+    }
+    "us-east1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
@@ -75,7 +71,7 @@ resource "ybm_cluster" "multi_region_sync_centralized_backup" {
       public_access                 = true
       backup_replication_gcp_target = "central-backup-bucket" # Same for all regions
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "REGION"
@@ -100,27 +96,24 @@ resource "ybm_cluster" "multi_region_geo_region_specific_backup" {
   cluster_name = "multi-region-geo-region-specific-backup"
   cloud_type   = "GCP"
   cluster_type = "GEO_PARTITIONED"
-  cluster_region_info = [
-    {
-      region                        = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
       vpc_id                        = "example-vpc-id-1"
       public_access                 = true
       backup_replication_gcp_target = "us-west-backup-bucket" # Region-specific
-    },
-    {
-      region                        = "asia-east1"
+    }
+    "asia-east1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
       vpc_id                        = "example-vpc-id-2"
       public_access                 = true
       backup_replication_gcp_target = "asia-east-backup-bucket" # Region-specific
-    },
-    {
-      region                        = "europe-central2"
+    }
+    "europe-central2" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
@@ -128,7 +121,7 @@ resource "ybm_cluster" "multi_region_geo_region_specific_backup" {
       public_access                 = true
       backup_replication_gcp_target = "europe-central-backup-bucket" # Region-specific
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "REGION"
@@ -153,27 +146,24 @@ resource "ybm_cluster" "asymmetric_geo_mixed_backup" {
   cluster_name = "asymmetric-geo-mixed-backup"
   cloud_type   = "GCP"
   cluster_type = "GEO_PARTITIONED"
-  cluster_region_info = [
-    {
-      region                        = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes                     = 1
       num_cores                     = 2
       disk_size_gb                  = 50
       vpc_id                        = "example-vpc-id-1"
       public_access                 = true
       backup_replication_gcp_target = "us-west-backup-bucket"
-    },
-    {
-      region                        = "us-central1"
+    }
+    "us-central1" = {
       num_nodes                     = 1
       num_cores                     = 4
       disk_size_gb                  = 100
       vpc_id                        = "example-vpc-id-2"
       public_access                 = true
       backup_replication_gcp_target = "us-central-backup-bucket"
-    },
-    {
-      region                        = "us-east1"
+    }
+    "us-east1" = {
       num_nodes                     = 1
       num_cores                     = 4
       disk_size_gb                  = 100
@@ -181,7 +171,7 @@ resource "ybm_cluster" "asymmetric_geo_mixed_backup" {
       public_access                 = true
       backup_replication_gcp_target = "us-central-backup-bucket" # Same as us-central1
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "REGION"
@@ -18,26 +18,23 @@ resource "ybm_cluster" "geo_partitioned_backup_replication" {
   cluster_type = "GEO_PARTITIONED"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-1"
       num_cores = 2
-    },
-    {
-      region    = "asia-east1"
+    }
+    "asia-east1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-2"
       num_cores = 2
-    },
-    {
-      region    = "europe-central2"
+    }
+    "europe-central2" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-3"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "REGION"
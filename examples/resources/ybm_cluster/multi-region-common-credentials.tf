@@ -9,26 +9,23 @@ resource "ybm_cluster" "multi_region_cluster" {
   cluster_name = "multi-region-cluster"
   cloud_type   = "GCP"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id" #Optional
       #vpc_name = "example-vpc-name" #Optional You can also use the VPC Name in place of vpc_id
-    },
-    {
-      region    = "asia-east1"
+    }
+    "asia-east1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id" #Optional
       #vpc_name = "example-vpc-name" #Optional You can also use the VPC Name in place of vpc_id
-    },
-    {
-      region    = "europe-central2"
+    }
+    "europe-central2" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id" #Optional
       #vpc_name = "example-vpc-name" #Optional You can also use the VPC Name in place of vpc_id
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = ["example-allow-list-id-1", "example-allow-list-id-2"] #Optional
   restore_backup_id      = "example-backup-id"                                    #Optional
@@ -18,26 +18,23 @@ resource "ybm_cluster" "multi_region_sync_backup_replication" {
   cluster_type = "SYNCHRONOUS"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-1"
       num_cores = 2
-    },
-    {
-      region    = "us-central1"
+    }
+    "us-central1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-2"
       num_cores = 2
-    },
-    {
-      region    = "us-east1"
+    }
+    "us-east1" = {
       num_nodes = 1
       vpc_id    = "example-vpc-id-3"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "REGION"
@@ -8,14 +8,13 @@ resource "ybm_cluster" "single_region_cluster" {
   cluster_name = "single-region-cluster"
   cloud_type   = "AZURE"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region    = "eastus"
+  cluster_region_info = {
+    "eastus" = {
       num_nodes = 3
       vpc_id    = ybm_vpc.example-vpc.vpc_id # Azure requires a VPC
       #vpc_name = "example-vpc-name" # You can also use the VPC Name in place of vpc_id
     }
-  ]
+  }
   cluster_tier           = "PAID" # Azure only supports PAID tier
   cluster_allow_list_ids = []     # Optional
   fault_tolerance        = "ZONE"
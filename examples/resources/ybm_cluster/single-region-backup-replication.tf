@@ -18,14 +18,13 @@ resource "ybm_cluster" "single_region_backup_replication" {
   cluster_type = "SYNCHRONOUS"
   cluster_tier = "PAID"
 
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 3
       vpc_id    = "example-vpc-id"
       num_cores = 2
     }
-  ]
+  }
 
   cluster_allow_list_ids = ["example-allow-list-id"]
   fault_tolerance        = "ZONE"
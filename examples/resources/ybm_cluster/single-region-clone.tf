@@ -0,0 +1,41 @@
+variable "password" {
+  type        = string
+  description = "YSQL and YCQL Password."
+  sensitive   = true
+}
+
+variable "production_cluster_id" {
+  type        = string
+  description = "The ID of the cluster to clone."
+}
+
+# Staging cluster created from the most recent backup of the production cluster
+resource "ybm_cluster" "staging" {
+  cluster_name = "staging-cluster"
+  cloud_type   = "AWS"
+  cluster_type = "SYNCHRONOUS"
+  cluster_region_info = {
+    "us-west-2" = {
+      num_nodes    = 1
+      num_cores    = 4
+      disk_size_gb = 50
+    }
+  }
+  cluster_tier    = "PAID"
+  fault_tolerance = "NONE"
+  clone_from = {
+    source_cluster_id = var.production_cluster_id
+    most_recent       = true # Or timestamp = "2024-05-01T00:00:00Z", or backup_id = "..."
+    ysql_databases    = ["orders"]
+    ysql_databases_rename = [
+      {
+        backup_database  = "orders"
+        restore_database = "orders_staging"
+      }
+    ]
+  }
+  credentials = {
+    username = "example_user"
+    password = var.password
+  }
+}
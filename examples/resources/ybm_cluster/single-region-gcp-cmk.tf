@@ -19,12 +19,11 @@ resource "ybm_cluster" "single_region" {
   # The cloud provider for the cluster is indepedent of the CMK Provider
   cloud_type   = "GCP"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region    = "us-west1"
+  cluster_region_info = {
+    "us-west1" = {
       num_nodes = 6
     }
-  ]
+  }
   cluster_tier = "PAID"
   # fault tolerance cannot be NONE for CMK enabled cluster
   fault_tolerance = "ZONE"
@@ -28,14 +28,13 @@ resource "ybm_cluster" "single_region_cluster" {
   cluster_name = "single-region-cluster"
   cloud_type   = "AWS"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region        = "us-east-1"
+  cluster_region_info = {
+    "us-east-1" = {
       num_nodes     = 1
       vpc_id        = ybm_vpc.example-vpc.vpc_id
       public_access = true
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = [ybm_allow_list.example_allow_list.allow_list_id]
   fault_tolerance        = "NONE"
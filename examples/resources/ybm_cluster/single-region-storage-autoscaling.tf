@@ -0,0 +1,31 @@
+variable "password" {
+  type        = string
+  description = "YSQL and YCQL Password."
+  sensitive   = true
+}
+
+# Single Region Cluster whose disks grow automatically when they fill up
+resource "ybm_cluster" "storage_autoscaling_cluster" {
+  cluster_name = "storage-autoscaling-cluster"
+  cloud_type   = "AWS"
+  cluster_type = "SYNCHRONOUS"
+  cluster_region_info = {
+    "us-west-2" = {
+      num_nodes    = 3
+      num_cores    = 4
+      disk_size_gb = 100
+    }
+  }
+  cluster_tier    = "PAID"
+  fault_tolerance = "ZONE"
+  storage_autoscaling = {
+    enabled              = true
+    threshold_percentage = 80  # Grow the disk once it is 80% full
+    increment_gb         = 50  # Grow the disk by 50 GB at a time
+    max_disk_size_gb     = 500 # Never grow the disk beyond 500 GB
+  }
+  credentials = {
+    username = "example_user"
+    password = var.password
+  }
+}
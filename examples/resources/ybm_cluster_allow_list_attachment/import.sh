@@ -0,0 +1,4 @@
+# The attachment of an allow list to a cluster can be imported using the allow list id and the cluster id.
+
+# Example:
+terraform import ybm_cluster_allow_list_attachment.my_attachment allow_list_id,cluster_id
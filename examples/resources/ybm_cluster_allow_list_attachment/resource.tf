@@ -0,0 +1,22 @@
+# The platform team manages the office allow list of the cluster
+resource "ybm_allow_list" "office" {
+  allow_list_name        = "office"
+  allow_list_description = "Office network"
+  cidr_list              = ["203.0.113.0/24"]
+}
+
+resource "ybm_cluster_allow_list_attachment" "office" {
+  cluster_id    = ybm_cluster.example_cluster.cluster_id
+  allow_list_id = ybm_allow_list.office.allow_list_id
+}
+
+# An application module attaches its own allow list to the same cluster
+resource "ybm_allow_list" "app" {
+  allow_list_name = "app-servers"
+  cidr_list       = ["198.51.100.0/24"]
+}
+
+resource "ybm_cluster_allow_list_attachment" "app" {
+  cluster_id    = ybm_cluster.example_cluster.cluster_id
+  allow_list_id = ybm_allow_list.app.allow_list_id
+}
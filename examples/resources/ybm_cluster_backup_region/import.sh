@@ -0,0 +1,4 @@
+# Backup regions of a cluster can be imported using the cluster id.
+
+# Example:
+terraform import ybm_cluster_backup_region.my_cluster_backup_region cluster_id
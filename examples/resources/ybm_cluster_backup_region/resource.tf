@@ -0,0 +1,11 @@
+# Store the backups of a synchronous cluster in one of its regions
+resource "ybm_cluster_backup_region" "single_region" {
+  cluster_id     = ybm_cluster.multi_region_cluster.cluster_id
+  backup_regions = ["us-east-1"]
+}
+
+# Store the backups of a geo-partitioned cluster in each of its regions
+resource "ybm_cluster_backup_region" "per_region" {
+  cluster_id     = ybm_cluster.geo_partitioned_cluster.cluster_id
+  backup_regions = keys(ybm_cluster.geo_partitioned_cluster.cluster_region_info)
+}
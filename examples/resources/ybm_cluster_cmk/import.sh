@@ -0,0 +1,5 @@
+# Customer managed key of a cluster can be imported using the cluster id.
+# The credentials of the key are not returned by the API and are taken from the configuration on the next apply.
+
+# Example:
+terraform import ybm_cluster_cmk.my_cluster_cmk cluster_id
@@ -21,4 +21,43 @@ resource "ybm_vpc_peering" "example_vpc_peering" {
     project = "example_project"
     vpc_id  = "application_vpc_id"
   }
-}
\ No newline at end of file
+}
+#AWS VPC Peering accepted in the same configuration
+resource "ybm_vpc_peering" "accepted_vpc_peering" {
+  name              = "example_name"
+  yugabytedb_vpc_id = "example_vpc_id"
+  application_vpc_info = {
+    cloud      = "AWS"
+    account_id = "example_account_id"
+    region     = "us-west1"
+    vpc_id     = "application_vpc_id"
+    cidr       = "example_cidr"
+  }
+}
+
+resource "aws_vpc_peering_connection_accepter" "yugabytedb" {
+  vpc_peering_connection_id = ybm_vpc_peering.accepted_vpc_peering.peering_connection_id
+  auto_accept               = true
+}
+
+resource "aws_route" "yugabytedb" {
+  for_each                  = toset(ybm_vpc_peering.accepted_vpc_peering.application_route_cidrs)
+  route_table_id            = "application_route_table_id"
+  destination_cidr_block    = each.value
+  vpc_peering_connection_id = ybm_vpc_peering.accepted_vpc_peering.peering_connection_id
+}
+
+#VPC Peering accepted outside of Terraform, waiting up to 2 hours
+resource "ybm_vpc_peering" "waiting_vpc_peering" {
+  name              = "example_name"
+  yugabytedb_vpc_id = "example_vpc_id"
+  application_vpc_info = {
+    cloud      = "AWS"
+    account_id = "example_account_id"
+    region     = "us-west1"
+    vpc_id     = "application_vpc_id"
+    cidr       = "example_cidr"
+  }
+  wait_for_active                 = true
+  wait_for_active_timeout_in_mins = 120
+}
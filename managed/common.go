@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -37,52 +38,24 @@ func getProjectId(ctx context.Context, apiClient *openapiclient.APIClient, accou
 	return projectId, true, ""
 }
 
-func getMemoryFromInstanceType(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, cloud string, tier string, region string, numCores int32) (memory int32, memoryOK bool, errorMessage string) {
-	instanceResp, resp, err := apiClient.ClusterApi.GetSupportedNodeConfigurationsByAccount(context.Background(), accountId).Cloud(cloud).Tier(tier).Regions([]string{region}).Execute()
-	if err != nil {
-		errMsg := getErrorMessage(resp, err)
-		return 0, false, errMsg
-	}
-	instanceData := instanceResp.GetData()
-	nodeConfigList, ok := instanceData[region]
-	if !ok || len(nodeConfigList) == 0 {
-		return 0, false, "No instances configured for the given region."
-	}
-	for _, nodeConfig := range nodeConfigList {
-		if nodeConfig.GetNumCores() == numCores {
-			memory = nodeConfig.GetMemoryMb()
-			tflog.Debug(ctx, fmt.Sprintf("Found an instance type with %v cores and %v MB memory in %v cloud in the region %v", numCores, memory, cloud, region))
-			return memory, true, ""
-		}
+func getMemoryFromInstanceType(ctx context.Context, apiClient *openapiclient.APIClient, catalog *nodeConfigCatalog, accountId string, cloud string, tier string, region string, numCores int32) (memory int32, memoryOK bool, errorMessage string) {
+	nodeConfig, nodeConfigOK, message := catalog.getNodeConfigOption(ctx, apiClient, accountId, cloud, tier, region, numCores)
+	if !nodeConfigOK {
+		return 0, false, message
 	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Could not find a instance with %v cores in %v cloud in the region %v", numCores, cloud, region))
-
-	return 0, false, "Node with the given number of CPU cores doesn't exist in the given region."
+	memory = int32(nodeConfig.MemoryMb)
+	tflog.Debug(ctx, fmt.Sprintf("Found an instance type with %v cores and %v MB memory in %v cloud in the region %v", numCores, memory, cloud, region))
+	return memory, true, ""
 }
 
-func getDiskSizeFromInstanceType(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, cloud string, tier string, region string, numCores int32) (diskSize int32, diskSizeOK bool, errorMessage string) {
-	instanceResp, resp, err := apiClient.ClusterApi.GetSupportedNodeConfigurationsByAccount(context.Background(), accountId).Cloud(cloud).Tier(tier).Regions([]string{region}).Execute()
-	if err != nil {
-		errMsg := getErrorMessage(resp, err)
-		return 0, false, errMsg
-	}
-	instanceData := instanceResp.GetData()
-	nodeConfigList, ok := instanceData[region]
-	if !ok || len(nodeConfigList) == 0 {
-		return 0, false, "No instances configured for the given region."
-	}
-	for _, nodeConfig := range nodeConfigList {
-		if nodeConfig.GetNumCores() == numCores {
-			diskSize = nodeConfig.GetIncludedDiskSizeGb()
-			tflog.Debug(ctx, fmt.Sprintf("Found an instance type with %v cores and %v GB disk size in %v cloud in the region %v", numCores, diskSize, cloud, region))
-			return diskSize, true, ""
-		}
+func getDiskSizeFromInstanceType(ctx context.Context, apiClient *openapiclient.APIClient, catalog *nodeConfigCatalog, accountId string, cloud string, tier string, region string, numCores int32) (diskSize int32, diskSizeOK bool, errorMessage string) {
+	nodeConfig, nodeConfigOK, message := catalog.getNodeConfigOption(ctx, apiClient, accountId, cloud, tier, region, numCores)
+	if !nodeConfigOK {
+		return 0, false, message
 	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Could not find a instance with %v cores in %v cloud in the region %v", numCores, cloud, region))
-
-	return 0, false, "Node with the given number of CPU cores doesn't exist in the given region."
+	diskSize = int32(nodeConfig.IncludedDiskSizeGb)
+	tflog.Debug(ctx, fmt.Sprintf("Found an instance type with %v cores and %v GB disk size in %v cloud in the region %v", numCores, diskSize, cloud, region))
+	return diskSize, true, ""
 }
 
 func getTrackId(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, trackName string) (trackId string, trackIdOK bool, errorMessage string) {
@@ -95,9 +68,7 @@ func getTrackId(ctx context.Context, apiClient *openapiclient.APIClient, account
 
 	for _, track := range tracksData {
 		tflog.Debug(ctx, fmt.Sprintf("Required track name:  %v, current track name: %v", track.Spec.GetName(), trackName))
-		if track.Spec.GetName() == trackName ||
-			(track.Spec.GetName() == "Extended" && (trackName == "Production" || trackName == "Innovation")) ||
-			(track.Spec.GetName() == "Rapid" && (trackName == "Preview" || trackName == "Early Access")) {
+		if track.Spec.GetName() == trackName || track.Spec.GetName() == util.CanonicalTrackName(trackName) {
 			return track.Info.GetId(), true, ""
 		}
 	}
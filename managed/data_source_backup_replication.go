@@ -0,0 +1,237 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type dataSourceBackupReplicationType struct{}
+
+func backupReplicationComputedString(description string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: description,
+		Type:        types.StringType,
+		Computed:    true,
+	}
+}
+
+// backupReplicationConfigAttributes returns the attributes shared by every replication
+// configuration, with the region attribute named after the cluster type.
+func backupReplicationConfigAttributes(regionAttribute string, regionDescription string) map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		regionAttribute:                backupReplicationComputedString(regionDescription),
+		"target":                       backupReplicationComputedString("The GCS bucket name where the backups are replicated."),
+		"config_state":                 backupReplicationComputedString("The current state of the replication configuration (e.g., ENABLED, DISABLED etc.)."),
+		"id":                           backupReplicationComputedString("Unique identifier for the replication configuration."),
+		"next_transfer_operation_time": backupReplicationComputedString("Timestamp of the next scheduled backup transfer operation."),
+		"latest_transfer_operation_details": {
+			Description: "Details about the most recent backup transfer operation.",
+			Computed:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"start_time": backupReplicationComputedString("Start time of the latest transfer operation."),
+				"end_time":   backupReplicationComputedString("End time of the latest transfer operation."),
+				"status":     backupReplicationComputedString("Status of the latest transfer operation (e.g., SUCCESS, FAILED, IN_PROGRESS)."),
+			}),
+		},
+		"expiry_on": backupReplicationComputedString("Timestamp when this replication configuration expires, if applicable."),
+	}
+}
+
+func (r dataSourceBackupReplicationType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	expiryConfigs := tfsdk.Attribute{
+		Description: "List of replication configurations that are set to expire.",
+		Computed:    true,
+		Attributes:  tfsdk.ListNestedAttributes(backupReplicationConfigAttributes("region", "The region associated with this replication configuration.")),
+	}
+
+	return tfsdk.Schema{
+		Description: "The data source to fetch the backup replication configuration of a cluster and the status of its backup transfers. Backup replication is only available for paid GCP clusters.",
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"cluster_type": {
+				Description: "The type of the cluster: SYNCHRONOUS or GEO_PARTITIONED.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"has_failed_transfers": {
+				Description: "True if the latest transfer operation of any replication configuration of the cluster failed.",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"gcp_spec": {
+				Description: "The GCP backup replication configuration of the cluster. Null when backup replication was never configured for the cluster.",
+				Computed:    true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"enabled": {
+						Description: "Whether GCP backup replication is enabled for this cluster.",
+						Type:        types.BoolType,
+						Computed:    true,
+					},
+					"sync_cluster_spec": {
+						Description: "Backup replication configuration of SYNCHRONOUS clusters.",
+						Computed:    true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"replication_config": {
+								Description: "The replication configuration of the cluster.",
+								Computed:    true,
+								Attributes:  tfsdk.SingleNestedAttributes(backupReplicationConfigAttributes("assigned_region", "The designated backup region from where the backups are replicated.")),
+							},
+							"configs_set_for_expiry": expiryConfigs,
+						}),
+					},
+					"geo_partitioned_cluster_spec": {
+						Description: "Backup replication configuration of GEO_PARTITIONED clusters.",
+						Computed:    true,
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"replication_configs": {
+								Description: "The replication configurations, one for each region of the cluster.",
+								Computed:    true,
+								Attributes:  tfsdk.ListNestedAttributes(backupReplicationConfigAttributes("desired_region", "The region of the cluster this replication configuration belongs to.")),
+							},
+							"configs_set_for_expiry": expiryConfigs,
+						}),
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceBackupReplicationType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceBackupReplication{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceBackupReplication struct {
+	p provider
+}
+
+func isFailedTransfer(details *GcpBackupReplicationLatestTransferOperationDetails) bool {
+	return details != nil && strings.EqualFold(details.Status.Value, "FAILED")
+}
+
+func hasFailedBackupTransfers(spec *BackupReplicationSpec) bool {
+	if spec == nil || spec.GCPSpec == nil {
+		return false
+	}
+	var expiryConfigs []GcpBackupReplicationExpiryConfig
+	if syncSpec := spec.GCPSpec.SyncClusterSpec; syncSpec != nil {
+		if syncSpec.ReplicationConfig != nil && isFailedTransfer(syncSpec.ReplicationConfig.LatestTransferOperationDetails) {
+			return true
+		}
+		expiryConfigs = append(expiryConfigs, syncSpec.ConfigsSetForExpiry...)
+	}
+	if geoSpec := spec.GCPSpec.GeoPartitionedClusterSpec; geoSpec != nil {
+		for _, config := range geoSpec.ReplicationConfigs {
+			if isFailedTransfer(config.LatestTransferOperationDetails) {
+				return true
+			}
+		}
+		expiryConfigs = append(expiryConfigs, geoSpec.ConfigsSetForExpiry...)
+	}
+	for _, config := range expiryConfigs {
+		if isFailedTransfer(config.LatestTransferOperationDetails) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r dataSourceBackupReplication) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config BackupReplication
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	clusterId := config.ClusterID.Value
+	clusterResp, response, err := apiClient.ClusterApi.GetCluster(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to get the cluster %v", clusterId), errMsg)
+		return
+	}
+	clusterSpec := clusterResp.Data.Spec
+	clusterType := string(clusterSpec.ClusterInfo.GetClusterType())
+
+	cloudType := ""
+	if len(clusterSpec.ClusterRegionInfo) > 0 {
+		cloudType = string(clusterSpec.ClusterRegionInfo[0].PlacementInfo.CloudInfo.GetCode())
+	}
+
+	// Backup replication is only offered for paid GCP clusters, there is nothing to read for the others.
+	var backupReplicationSpec *BackupReplicationSpec
+	if cloudType == "GCP" && string(clusterSpec.ClusterInfo.ClusterTier) == "PAID" {
+		backupReplicationSpec, err = readGcpBackupReplicationState(ctx, apiClient, accountId, projectId, clusterId, clusterType)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to read the backup replication of the cluster %v", clusterId), err.Error())
+			return
+		}
+		if backupReplicationSpec != nil && backupReplicationSpec.GCPSpec != nil {
+			alignGeoGcpReplicationOrder(backupReplicationSpec.GCPSpec.GeoPartitionedClusterSpec, nil)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Backup Replication Read: cluster %v of type %v on %v", clusterId, clusterType, cloudType))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.ClusterType = types.String{Value: clusterType}
+	config.HasFailedTransfers = types.Bool{Value: hasFailedBackupTransfers(backupReplicationSpec)}
+	config.GCPSpec = nil
+	if backupReplicationSpec != nil {
+		config.GCPSpec = backupReplicationSpec.GCPSpec
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,309 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type dataSourceBackupsType struct{}
+
+func (r dataSourceBackupsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The data source to list the backups of the project, optionally filtered by cluster, state, type, creation time and description.",
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account the backups belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project the backups belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "Only return the backups of this cluster.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"state": {
+				Description: "Only return the backups in this state, for example SUCCEEDED or FAILED.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"backup_type": {
+				Description: "Only return the backups of this type: SCHEDULED, ON_DEMAND or INCREMENTAL.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("SCHEDULED", "ON_DEMAND", "INCREMENTAL")},
+			},
+			"created_after": {
+				Description: "Only return the backups created at or after this time, in RFC 3339 format, for example 2024-01-02T15:04:05Z.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"created_before": {
+				Description: "Only return the backups created at or before this time, in RFC 3339 format, for example 2024-01-02T15:04:05Z.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"description_contains": {
+				Description: "Only return the backups whose description contains this text. The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"backups": {
+				Description: "The backups matching the filters, most recent first.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"backup_id": {
+						Description: "The ID of the backup.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cluster_id": {
+						Description: "The ID of the backed up cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the backup.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"backup_type": {
+						Description: "The type of the backup: SCHEDULED, ON_DEMAND or INCREMENTAL.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"backup_description": {
+						Description: "The description of the backup.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"created_on": {
+						Description: "The time the backup started.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"completed_on": {
+						Description: "The time the backup completed. Null while the backup is running.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"duration_in_secs": {
+						Description: "How long the backup took. Null while the backup is running.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"expires_on": {
+						Description: "The time the backup is deleted at, at the end of its retention period.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"retention_period_in_days": {
+						Description: "The retention period of the backup.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"size_in_bytes": {
+						Description: "The size of the backup.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"included_databases": {
+						Description: "The YSQL databases and YCQL keyspaces in the backup.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceBackupsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceBackups{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceBackups struct {
+	p provider
+}
+
+// parseOptionalTime parses an optional RFC 3339 attribute. ok is false if the value is invalid.
+func parseOptionalTime(value types.String) (t time.Time, set bool, ok bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return t, false, true
+	}
+	t, err := time.Parse(time.RFC3339, value.Value)
+	if err != nil {
+		return t, false, false
+	}
+	return t, true, true
+}
+
+func flattenBackup(data openapiclient.BackupData) ListedBackup {
+	createdOn := data.Info.Metadata.Get().GetCreatedOn()
+	completedOn := data.Info.GetCompletedOn()
+
+	duration := types.Int64{Null: true}
+	if seconds, ok := util.DurationSeconds(createdOn, completedOn); ok {
+		duration = types.Int64{Value: seconds}
+	}
+
+	description := types.String{Null: true}
+	if data.Spec.Description.Get() != nil {
+		description = types.String{Value: *data.Spec.Description.Get()}
+	}
+	retentionPeriodInDays := types.Int64{Null: true}
+	if data.Spec.RetentionPeriodInDays != nil {
+		retentionPeriodInDays = types.Int64{Value: int64(*data.Spec.RetentionPeriodInDays)}
+	}
+
+	includedDatabases := []types.String{}
+	for _, database := range data.Info.GetDatabases() {
+		includedDatabases = append(includedDatabases, types.String{Value: database})
+	}
+
+	return ListedBackup{
+		BackupID:              types.String{Value: data.Info.GetId()},
+		ClusterID:             types.String{Value: data.Spec.ClusterId},
+		State:                 types.String{Value: string(data.Info.GetState())},
+		BackupType:            types.String{Value: util.BackupType(string(data.Info.GetActionType()), data.Info.GetIsIncremental())},
+		BackupDescription:     description,
+		CreatedOn:             types.String{Value: createdOn},
+		CompletedOn:           stringValueOrNull(completedOn),
+		DurationInSecs:        duration,
+		ExpiresOn:             stringValueOrNull(data.Info.GetExpiryTime()),
+		RetentionPeriodInDays: retentionPeriodInDays,
+		SizeInBytes:           types.Int64{Value: data.Info.GetActualSizeInBytes()},
+		IncludedDatabases:     includedDatabases,
+	}
+}
+
+func (r dataSourceBackups) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config Backups
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdAfter, createdAfterSet, ok := parseOptionalTime(config.CreatedAfter)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid created_after",
+			fmt.Sprintf("%v is not in RFC 3339 format, for example 2024-01-02T15:04:05Z.", config.CreatedAfter.Value))
+	}
+	createdBefore, createdBeforeSet, ok := parseOptionalTime(config.CreatedBefore)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid created_before",
+			fmt.Sprintf("%v is not in RFC 3339 format, for example 2024-01-02T15:04:05Z.", config.CreatedBefore.Value))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	listBackups := func(continuationToken string) openapiclient.ApiListBackupsRequest {
+		apiRequest := apiClient.BackupApi.ListBackups(ctx, accountId, projectId)
+		if !config.ClusterID.IsNull() {
+			apiRequest = apiRequest.ClusterId(config.ClusterID.Value)
+		}
+		if !config.State.IsNull() {
+			apiRequest = apiRequest.State(config.State.Value)
+		}
+		if continuationToken != "" {
+			apiRequest = apiRequest.ContinuationToken(continuationToken)
+		}
+		return apiRequest
+	}
+
+	backups := make([]ListedBackup, 0)
+	backupsResp, response, err := listBackups("").Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError("Unable to list the backups", errMsg)
+			return
+		}
+		// The backups are ordered by creation time, most recent first
+		olderThanRange := false
+		for _, data := range backupsResp.Data {
+			backup := flattenBackup(data)
+			createdOn, err := time.Parse(time.RFC3339, backup.CreatedOn.Value)
+			if err != nil && (createdAfterSet || createdBeforeSet) {
+				tflog.Warn(ctx, fmt.Sprintf("Skipping backup %v with unexpected creation time: %v", backup.BackupID.Value, err))
+				continue
+			}
+			if createdAfterSet && createdOn.Before(createdAfter) {
+				olderThanRange = true
+				break
+			}
+			if createdBeforeSet && createdOn.After(createdBefore) {
+				continue
+			}
+			if !config.BackupType.IsNull() && backup.BackupType.Value != config.BackupType.Value {
+				continue
+			}
+			if !config.DescriptionContains.IsNull() &&
+				!strings.Contains(strings.ToLower(backup.BackupDescription.Value), strings.ToLower(config.DescriptionContains.Value)) {
+				continue
+			}
+			backups = append(backups, backup)
+		}
+		if olderThanRange || !backupsResp.Metadata.HasContinuationToken() {
+			break
+		}
+		backupsResp, response, err = listBackups(backupsResp.Metadata.GetContinuationToken()).Execute()
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Backups Read: %v backups match the filters", len(backups)))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.Backups = backups
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
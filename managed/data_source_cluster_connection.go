@@ -0,0 +1,210 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+)
+
+type dataSourceClusterConnectionType struct{}
+
+func (r dataSourceClusterConnectionType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The data source to fetch ready-to-use YSQL and YCQL connection details of a cluster in YugabyteDB Aeon.
+One connection is returned for every endpoint of the cluster, that is for every accessibility type and region.
+All the connection strings verify the server certificate (sslmode=verify-full) against the cluster CA certificate,
+which has to be saved to the file given in ssl_root_cert_path.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"username": {
+				Description: "The database user to put in the connection strings. The password is never part of the connection strings.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"database": {
+				Description: "The YSQL database to connect to. Defaults to yugabyte.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssl_root_cert_path": {
+				Description: "Path of the file the client reads the CA certificate from. Defaults to root.crt.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ca_certificate": {
+				Description: "The PEM encoded CA certificate used to verify the cluster endpoints.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"connections": {
+				Description: "The connection details of every endpoint of the cluster.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"accessibility_type": {
+						Description: "The accessibility type of the endpoint. PUBLIC, PRIVATE or PRIVATE_SERVICE_ENDPOINT.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"region": {
+						Description: "The region of the endpoint.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"host": {
+						Description: "The host of the endpoint.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"ysql_uri": {
+						Description: "The postgresql:// URI to connect to YSQL.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"jdbc_url": {
+						Description: "The JDBC URL to connect to YSQL with the YugabyteDB JDBC driver.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"ycql_contact_point": {
+						Description: "The host:port contact point to connect to YCQL.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"load_balance_params": {
+						Description: "The query parameters that make the YugabyteDB smart drivers balance connections across the nodes of the region. Append them to ysql_uri.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"jdbc_load_balance_params": {
+						Description: "The query parameters that make the YugabyteDB JDBC smart driver balance connections across the nodes of the region. Append them to jdbc_url.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceClusterConnectionType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceClusterConnection{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceClusterConnection struct {
+	p provider
+}
+
+func (r dataSourceClusterConnection) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config ClusterConnection
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Database.IsNull() || config.Database.Value == "" {
+		config.Database = types.String{Value: "yugabyte"}
+	}
+	if config.SSLRootCertPath.IsNull() || config.SSLRootCertPath.Value == "" {
+		config.SSLRootCertPath = types.String{Value: "root.crt"}
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	clusterId := config.ClusterID.Value
+	clusterResp, response, err := apiClient.ClusterApi.GetCluster(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to read the cluster %v", clusterId), errMsg)
+		return
+	}
+
+	certResponse, certHttpResp, err := apiClient.ClusterApi.GetConnectionCertificate(ctx).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(certHttpResp, err)
+		resp.Diagnostics.AddError("Unable to fetch the cluster certificate", errMsg)
+		return
+	}
+
+	// Topology keys of the smart drivers need the cloud of each region
+	regionClouds := map[string]string{}
+	for _, regionInfo := range clusterResp.Data.Spec.ClusterRegionInfo {
+		regionClouds[regionInfo.PlacementInfo.CloudInfo.GetRegion()] = string(regionInfo.PlacementInfo.CloudInfo.GetCode())
+	}
+
+	connections := make([]ClusterConnectionEndpoint, 0)
+	for _, endpoint := range clusterResp.Data.Info.ClusterEndpoints {
+		host := endpoint.GetHost()
+		region := endpoint.Region
+		cloud := regionClouds[region]
+		tflog.Debug(ctx, fmt.Sprintf("Cluster connection for endpoint %v %v %v", endpoint.GetAccessibilityType(), region, host))
+
+		connections = append(connections, ClusterConnectionEndpoint{
+			AccessibilityType:     types.String{Value: string(endpoint.GetAccessibilityType())},
+			Region:                types.String{Value: region},
+			Host:                  types.String{Value: host},
+			YSQLURI:               types.String{Value: util.YSQLConnectionURI(host, config.Username.Value, config.Database.Value, config.SSLRootCertPath.Value)},
+			JDBCURL:               types.String{Value: util.JDBCConnectionURL(host, config.Username.Value, config.Database.Value, config.SSLRootCertPath.Value)},
+			YCQLContactPoint:      types.String{Value: util.YCQLContactPoint(host)},
+			LoadBalanceParams:     types.String{Value: util.SmartDriverLoadBalanceParams(cloud, region, false)},
+			JDBCLoadBalanceParams: types.String{Value: util.SmartDriverLoadBalanceParams(cloud, region, true)},
+		})
+	}
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.CACertificate = types.String{Value: *certResponse.Data}
+	config.Connections = connections
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -77,6 +77,11 @@ func (r dataClusterNameType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:     types.Int64Type,
 						Computed: true,
 					},
+					"current_disk_size_gb": {
+						Description: "The disk size of the nodes of the region as reported by the server.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
 					"disk_iops": {
 						Type:     types.Int64Type,
 						Computed: true,
@@ -167,6 +172,32 @@ func (r dataClusterNameType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					},
 				}),
 			},
+			"storage_autoscaling": {
+				Description: "Storage autoscaling policy of the cluster.",
+				Computed:    true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"enabled": {
+						Description: "Whether storage autoscaling is enabled.",
+						Type:        types.BoolType,
+						Computed:    true,
+					},
+					"threshold_percentage": {
+						Description: "Disk usage percentage at which the disk is grown.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"increment_gb": {
+						Description: "Size in GB by which the disk is grown each time the threshold is crossed.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"max_disk_size_gb": {
+						Description: "Maximum size in GB the disk may be grown to.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+				}),
+			},
 			"cmk_spec": {
 				Description: "KMS Provider Configuration.",
 				Computed:    true,
@@ -606,6 +637,88 @@ func (r dataClusterNameType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"clone_from": {
+				Description: "The backup the cluster was cloned from. Only known for clusters created with clone_from by the ybm_cluster resource.",
+				Computed:    true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"source_cluster_id": {
+						Description: "The ID of the cluster whose backup was restored.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"most_recent": {
+						Description: "Whether the most recent backup of the source cluster was restored.",
+						Type:        types.BoolType,
+						Computed:    true,
+					},
+					"timestamp": {
+						Description: "The time at or before which the restored backup was taken.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"backup_id": {
+						Description: "The ID of the backup of the source cluster to restore.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"restored_backup_id": {
+						Description: "The ID of the backup that was restored to create the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"use_roles": {
+						Description: "Whether global YSQL roles were restored.",
+						Type:        types.BoolType,
+						Computed:    true,
+					},
+					"ysql_databases": {
+						Description: "List of YSQL databases restored.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"ycql_keyspaces": {
+						Description: "List of YCQL keyspaces restored.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"ysql_databases_rename": {
+						Description: "List of YSQL database renames.",
+						Computed:    true,
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"backup_database": {
+								Description: "YSQL database name in the backup.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"restore_database": {
+								Description: "YSQL database name used on the cloned cluster.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+						}),
+					},
+					"ycql_keyspaces_rename": {
+						Description: "List of YCQL keyspace renames.",
+						Computed:    true,
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"backup_database": {
+								Description: "YCQL keyspace name in the backup.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"restore_database": {
+								Description: "YCQL keyspace name used on the cloned cluster.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+						}),
+					},
+				}),
+			},
 			"node_config": {
 				Computed: true,
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
@@ -812,17 +925,37 @@ func (r dataClusterName) Read(ctx context.Context, req tfsdk.ReadDataSourceReque
 		ScheduleID: types.String{Value: scheduleId},
 	}
 	backUpSchedule = append(backUpSchedule, backUpInfo)
-	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedule, make([]string, 0), true, make([]string, 0), true, "", apiClient)
+	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedule, true, make([]string, 0), true, "", apiClient)
 
 	if !readOK {
 		resp.Diagnostics.AddError("Unable to read the state of the cluster", message)
 		return
 	}
 
+	// The data source keeps listing the regions, in the order of the cluster spec
+	regionInfoMap := cluster.ClusterRegionInfo
+	var clusterRegionInfo []RegionInfo
+	for _, regionInfo := range clusterList[0].Spec.ClusterRegionInfo {
+		region := regionInfo.PlacementInfo.CloudInfo.GetRegion()
+		if info, ok := regionInfoMap[region]; ok {
+			info.Region = types.String{Value: region}
+			clusterRegionInfo = append(clusterRegionInfo, info)
+			delete(regionInfoMap, region)
+		}
+	}
+	clusterRegionInfo = append(clusterRegionInfo, regionInfoList(regionInfoMap)...)
+
+	// A nil map is set as a null list, which the list of the regions then replaces
+	cluster.ClusterRegionInfo = nil
 	diags := resp.State.Set(ctx, &cluster)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = resp.State.SetAttribute(ctx, path.Root("cluster_region_info"), clusterRegionInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 }
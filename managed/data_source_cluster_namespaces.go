@@ -0,0 +1,185 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type dataSourceClusterNamespacesType struct{}
+
+func (r dataSourceClusterNamespacesType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The data source to fetch the YSQL databases and YCQL keyspaces of a cluster.",
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"namespace_type": {
+				Description: "Only return the namespaces of this type: YSQL or YCQL.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("YSQL", "YCQL")},
+			},
+			"namespaces": {
+				Description: "The namespaces of the cluster, ordered by type and name.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"namespace_id": {
+						Description: "The ID of the namespace.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"namespace_name": {
+						Description: "The name of the namespace.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"namespace_type": {
+						Description: "The type of the namespace: YSQL for databases, YCQL for keyspaces.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"ysql_databases": {
+				Description: "The names of the YSQL databases of the cluster, sorted.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+			"ycql_keyspaces": {
+				Description: "The names of the YCQL keyspaces of the cluster, sorted.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceClusterNamespacesType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceClusterNamespaces{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceClusterNamespaces struct {
+	p provider
+}
+
+func (r dataSourceClusterNamespaces) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config ClusterNamespaces
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	clusterId := config.ClusterID.Value
+	namespacesResp, response, err := apiClient.ClusterApi.GetClusterNamespaces(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to get the namespaces of the cluster %v", clusterId), errMsg)
+		return
+	}
+
+	namespaceTypesByTableType := map[string]string{}
+	for namespaceType, tableType := range GetNamespaceTypeMap() {
+		namespaceTypesByTableType[tableType] = namespaceType
+	}
+
+	namespaces := make([]ClusterNamespace, 0)
+	ysqlDatabases := []types.String{}
+	ycqlKeyspaces := []types.String{}
+	for _, namespace := range namespacesResp.Data {
+		namespaceType, ok := namespaceTypesByTableType[namespace.GetTableType()]
+		if !ok {
+			tflog.Debug(ctx, fmt.Sprintf("Skipping the namespace %v of unsupported table type %v", namespace.GetName(), namespace.GetTableType()))
+			continue
+		}
+		if !config.NamespaceType.IsNull() && namespaceType != config.NamespaceType.Value {
+			continue
+		}
+		namespaces = append(namespaces, ClusterNamespace{
+			NamespaceID:   types.String{Value: namespace.GetId()},
+			NamespaceName: types.String{Value: namespace.GetName()},
+			NamespaceType: types.String{Value: namespaceType},
+		})
+		if namespaceType == "YSQL" {
+			ysqlDatabases = append(ysqlDatabases, types.String{Value: namespace.GetName()})
+		} else {
+			ycqlKeyspaces = append(ycqlKeyspaces, types.String{Value: namespace.GetName()})
+		}
+	}
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].NamespaceType.Value != namespaces[j].NamespaceType.Value {
+			return namespaces[i].NamespaceType.Value > namespaces[j].NamespaceType.Value
+		}
+		return namespaces[i].NamespaceName.Value < namespaces[j].NamespaceName.Value
+	})
+	sort.Slice(ysqlDatabases, func(i, j int) bool {
+		return ysqlDatabases[i].Value < ysqlDatabases[j].Value
+	})
+	sort.Slice(ycqlKeyspaces, func(i, j int) bool {
+		return ycqlKeyspaces[i].Value < ycqlKeyspaces[j].Value
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Cluster Namespaces Read: found %v namespaces in cluster %v", len(namespaces), clusterId))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.Namespaces = namespaces
+	config.YsqlDatabases = ysqlDatabases
+	config.YcqlKeyspaces = ycqlKeyspaces
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,252 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// taskStates are the states a task goes through.
+var taskStates = []string{"QUEUED", "IN_PROGRESS", "SUCCEEDED", "FAILED", "CANCELLED"}
+
+// taskTypes are the task types of the API, accepted by the task_type filter.
+func taskTypes() []string {
+	var taskTypeNames []string
+	for _, taskType := range openapiclient.AllowedTaskTypeEnumEnumValues {
+		taskTypeNames = append(taskTypeNames, string(taskType))
+	}
+	return taskTypeNames
+}
+
+type dataSourceClusterTasksType struct{}
+
+func (r dataSourceClusterTasksType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The data source to fetch the most recent tasks YugabyteDB Aeon ran on a cluster, such as edits, backups and restores,
+optionally filtered by task type and state. Up to limit tasks matching the filters are returned, however old they are.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"task_type": {
+				Description: "Only return the tasks of this type, for example EDIT_CLUSTER or EDIT_ALLOW_LIST. The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOfCaseInsensitive(taskTypes()...)},
+			},
+			"state": {
+				Description: "Only return the tasks in this state: " + strings.Join(taskStates, ", ") + ". The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOfCaseInsensitive(taskStates...)},
+			},
+			"limit": {
+				Description: "The maximum number of tasks matching the filters to return. Defaults to 20.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.Between(1, 100)},
+			},
+			"has_running_tasks": {
+				Description: "Whether any task of the cluster has not completed yet, regardless of the task_type and state filters.",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"tasks": {
+				Description: "The tasks matching the filters, most recent first.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"task_id": {
+						Description: "The ID of the task.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"task_type": {
+						Description: "The type of the task.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"entity_type": {
+						Description: "The type of the entity the task ran on.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the task.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"start_time": {
+						Description: "The time the task started.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"end_time": {
+						Description: "The time the task completed. Null while the task is running.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"error_message": {
+						Description: "The reason the task failed. Null unless the task failed.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceClusterTasksType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceClusterTasks{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceClusterTasks struct {
+	p provider
+}
+
+func (r dataSourceClusterTasks) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config ClusterTasks
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Limit.IsNull() {
+		config.Limit = types.Int64{Value: 20}
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	clusterId := config.ClusterID.Value
+	limit := int(config.Limit.Value)
+	listTasks := func(taskType string, pageSize int, continuationToken string) openapiclient.ApiListTasksRequest {
+		apiRequest := apiClient.TaskApi.ListTasks(ctx, accountId).ProjectId(projectId).EntityId(clusterId).EntityType(openapiclient.ENTITYTYPEENUM_CLUSTER).Limit(int32(pageSize))
+		if taskType != "" {
+			apiRequest = apiRequest.TaskType(openapiclient.TaskTypeEnum(taskType))
+		}
+		if continuationToken != "" {
+			apiRequest = apiRequest.ContinuationToken(continuationToken)
+		}
+		return apiRequest
+	}
+
+	// The task type is filtered by the API and the state here, so the tasks are listed until limit of them match
+	taskType := ""
+	if !config.TaskType.IsNull() {
+		taskType = strings.ToUpper(config.TaskType.Value)
+	}
+	tasks := make([]ClusterTask, 0)
+	taskList, response, err := listTasks(taskType, limit, "").Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list the tasks of the cluster %v", clusterId), errMsg)
+			return
+		}
+		// The tasks are ordered by creation time, most recent first
+		for _, task := range taskList.GetData() {
+			if len(tasks) == limit {
+				break
+			}
+			taskInfo := task.GetInfo()
+			if !config.State.IsNull() && !strings.EqualFold(taskInfo.GetState(), config.State.Value) {
+				continue
+			}
+			tasks = append(tasks, ClusterTask{
+				TaskID:       types.String{Value: taskInfo.GetId()},
+				TaskType:     types.String{Value: string(taskInfo.GetTaskType())},
+				EntityType:   types.String{Value: string(taskInfo.GetEntityType())},
+				State:        types.String{Value: taskInfo.GetState()},
+				StartTime:    stringValueOrNull(taskInfo.GetCreatedTime()),
+				EndTime:      stringValueOrNull(taskInfo.GetCompletedTime()),
+				ErrorMessage: stringValueOrNull(taskInfo.GetErrorMessage()),
+			})
+		}
+		if len(tasks) == limit || !taskList.Metadata.HasContinuationToken() {
+			break
+		}
+		taskList, response, err = listTasks(taskType, limit, taskList.Metadata.GetContinuationToken()).Execute()
+	}
+
+	// Running tasks are reported whatever the filters, to gate on a cluster without running tasks. All the tasks
+	// of the cluster are listed until a running one is found.
+	hasRunningTasks := false
+	taskList, response, err = listTasks("", 100, "").Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list the tasks of the cluster %v", clusterId), errMsg)
+			return
+		}
+		for _, task := range taskList.GetData() {
+			if util.IsTaskRunning(task.GetInfo().GetState()) {
+				hasRunningTasks = true
+				break
+			}
+		}
+		if hasRunningTasks || !taskList.Metadata.HasContinuationToken() {
+			break
+		}
+		taskList, response, err = listTasks("", 100, taskList.Metadata.GetContinuationToken()).Execute()
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Cluster Tasks Read: %v tasks of cluster %v match the filters", len(tasks), clusterId))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.HasRunningTasks = types.Bool{Value: hasRunningTasks}
+	config.Tasks = tasks
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,333 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type dataSourceClustersType struct{}
+
+func (r dataSourceClustersType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The data source to fetch all the clusters in YugabyteDB Aeon, optionally filtered by name, cloud, tier, state, region and database track.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account the clusters belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project the clusters belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name_regex": {
+				Description: "Only return the clusters whose name matches this regular expression.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"cloud_type": {
+				Description: "Only return the clusters deployed in this cloud: AWS, AZURE or GCP.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "AZURE", "GCP")},
+			},
+			"cluster_tier": {
+				Description: "Only return the clusters of this tier: FREE (Sandbox) or PAID (Dedicated).",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("FREE", "PAID")},
+			},
+			"state": {
+				Description: "Only return the clusters in this state, for example ACTIVE or PAUSED. The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"region": {
+				Description: "Only return the clusters that have nodes in this region.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"database_track": {
+				Description: "Only return the clusters on this database release track. Deprecated track names are mapped the same way as on the ybm_cluster resource.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"clusters": {
+				Description: "The clusters matching all the given filters.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"cluster_id": {
+						Description: "The ID of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cluster_name": {
+						Description: "The name of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cloud_type": {
+						Description: "The cloud provider where the cluster is deployed.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cluster_type": {
+						Description: "The type of the cluster. SYNCHRONOUS or GEO_PARTITIONED.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cluster_tier": {
+						Description: "FREE (Sandbox) or PAID (Dedicated).",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"fault_tolerance": {
+						Description: "The fault tolerance of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"num_faults_to_tolerate": {
+						Description: "The number of domain faults the cluster can tolerate.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"num_nodes": {
+						Description: "The total number of nodes of the cluster.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"regions": {
+						Description: "The regions the cluster has nodes in.",
+						Type:        types.ListType{ElemType: types.StringType},
+						Computed:    true,
+					},
+					"database_track": {
+						Description: "The database release track of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"software_version": {
+						Description: "The database software version of the cluster.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"created_time": {
+						Description: "The time the cluster was created.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceClustersType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceClusters{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceClusters struct {
+	p provider
+}
+
+func (r dataSourceClusters) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config Clusters
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	trackNames, trackNamesOK, message := getTrackNamesById(ctx, apiClient, accountId)
+	if !trackNamesOK {
+		resp.Diagnostics.AddError("Unable to list the database tracks", message)
+		return
+	}
+
+	trackId := ""
+	if !config.DatabaseTrack.IsNull() {
+		var trackIdOK bool
+		trackId, trackIdOK, message = getTrackId(ctx, apiClient, accountId, config.DatabaseTrack.Value)
+		if !trackIdOK {
+			resp.Diagnostics.AddError("Unable to find the database track "+config.DatabaseTrack.Value, message)
+			return
+		}
+	}
+
+	clusterList, readOK, message := listAllClusters(ctx, accountId, projectId, apiClient)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to list the clusters", message)
+		return
+	}
+
+	clusters := make([]ClusterSummary, 0)
+	for _, clusterData := range clusterList {
+		cluster := flattenClusterSummary(clusterData, trackNames)
+		if nameRegex != nil && !nameRegex.MatchString(cluster.ClusterName.Value) {
+			continue
+		}
+		if !config.CloudType.IsNull() && cluster.CloudType.Value != config.CloudType.Value {
+			continue
+		}
+		if !config.ClusterTier.IsNull() && cluster.ClusterTier.Value != config.ClusterTier.Value {
+			continue
+		}
+		if !config.State.IsNull() && !strings.EqualFold(cluster.State.Value, config.State.Value) {
+			continue
+		}
+		if !config.Region.IsNull() && !clusterHasRegion(cluster, config.Region.Value) {
+			continue
+		}
+		if trackId != "" && clusterData.Spec.SoftwareInfo.GetTrackId() != trackId {
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Clusters Read: %v of %v clusters match the filters", len(clusters), len(clusterList)))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.Clusters = clusters
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// listAllClusters pages through all the clusters of the project
+func listAllClusters(ctx context.Context, accountId string, projectId string, apiClient *openapiclient.APIClient) ([]openapiclient.ClusterData, bool, string) {
+	var clusters []openapiclient.ClusterData
+
+	clustersResp, response, err := apiClient.ClusterApi.ListClusters(ctx, accountId, projectId).Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			return nil, false, errMsg
+		}
+		clusters = append(clusters, clustersResp.GetData()...)
+		if !clustersResp.Metadata.HasContinuationToken() {
+			break
+		}
+		continuationToken := clustersResp.Metadata.GetContinuationToken()
+		clustersResp, response, err = apiClient.ClusterApi.ListClusters(ctx, accountId, projectId).ContinuationToken(continuationToken).Execute()
+	}
+
+	return clusters, true, ""
+}
+
+// getTrackNamesById maps the ID of every database track of the account to its name
+func getTrackNamesById(ctx context.Context, apiClient *openapiclient.APIClient, accountId string) (map[string]string, bool, string) {
+	tracksResp, response, err := apiClient.SoftwareReleaseApi.ListTracks(ctx, accountId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		return nil, false, errMsg
+	}
+	trackNames := map[string]string{}
+	for _, track := range tracksResp.GetData() {
+		trackNames[track.Info.GetId()] = track.Spec.GetName()
+	}
+	return trackNames, true, ""
+}
+
+func flattenClusterSummary(clusterData openapiclient.ClusterData, trackNames map[string]string) ClusterSummary {
+	clusterInfo := clusterData.Spec.ClusterInfo
+
+	cloudType := ""
+	numNodes := int64(0)
+	regions := []types.String{}
+	for _, regionInfo := range clusterData.Spec.ClusterRegionInfo {
+		cloudType = string(regionInfo.PlacementInfo.CloudInfo.GetCode())
+		numNodes += int64(regionInfo.PlacementInfo.GetNumNodes())
+		regions = append(regions, types.String{Value: regionInfo.PlacementInfo.CloudInfo.GetRegion()})
+	}
+
+	numFaultsToTolerate := types.Int64{Null: true}
+	if clusterInfo.NumFaultsToTolerate.Get() != nil {
+		numFaultsToTolerate = types.Int64{Value: int64(*clusterInfo.NumFaultsToTolerate.Get())}
+	}
+
+	databaseTrack := types.String{Null: true}
+	if trackName, ok := trackNames[clusterData.Spec.SoftwareInfo.GetTrackId()]; ok {
+		databaseTrack = types.String{Value: trackName}
+	}
+
+	return ClusterSummary{
+		ClusterID:           types.String{Value: clusterData.Info.GetId()},
+		ClusterName:         types.String{Value: clusterData.Spec.Name},
+		CloudType:           types.String{Value: cloudType},
+		ClusterType:         types.String{Value: string(clusterInfo.GetClusterType())},
+		ClusterTier:         types.String{Value: string(clusterInfo.ClusterTier)},
+		State:               types.String{Value: string(clusterData.Info.GetState())},
+		FaultTolerance:      types.String{Value: string(clusterInfo.FaultTolerance)},
+		NumFaultsToTolerate: numFaultsToTolerate,
+		NumNodes:            types.Int64{Value: numNodes},
+		Regions:             regions,
+		DatabaseTrack:       databaseTrack,
+		SoftwareVersion:     types.String{Value: clusterData.Info.GetSoftwareVersion()},
+		CreatedTime:         types.String{Value: clusterData.Info.Metadata.Get().GetCreatedOn()},
+	}
+}
+
+func clusterHasRegion(cluster ClusterSummary, region string) bool {
+	for _, clusterRegion := range cluster.Regions {
+		if clusterRegion.Value == region {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+)
+
+type dataSourceDatabaseTracksType struct{}
+
+func (r dataSourceDatabaseTracksType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The data source to fetch the database release tracks available to the account and the releases on each track.",
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Only return the track with this name. Deprecated track names such as Production or Preview are accepted and resolved to the track they refer to.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"tracks": {
+				Description: "The database tracks, ordered by name.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"track_id": {
+						Description: "The ID of the track.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the track.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"aliases": {
+						Description: "The deprecated names the database_track of the cluster resource still accepts for this track.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"latest_release_version": {
+						Description: "The newest database version released on the track.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"releases": {
+						Description: "The releases on the track, newest first.",
+						Computed:    true,
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"release_id": {
+								Description: "The ID of the release.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"version": {
+								Description: "The database version of the release.",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"is_default": {
+								Description: "Whether new clusters on the track are created with this release.",
+								Type:        types.BoolType,
+								Computed:    true,
+							},
+						}),
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceDatabaseTracksType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceDatabaseTracks{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceDatabaseTracks struct {
+	p provider
+}
+
+func (r dataSourceDatabaseTracks) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config DatabaseTracks
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	tracksResp, response, err := apiClient.SoftwareReleaseApi.ListTracks(ctx, accountId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError("Unable to list the database tracks", errMsg)
+		return
+	}
+
+	tracks := make([]DatabaseTrack, 0)
+	for _, trackData := range tracksResp.GetData() {
+		trackId := trackData.Info.GetId()
+		trackName := trackData.Spec.GetName()
+		if !config.Name.IsNull() && trackName != config.Name.Value && trackName != util.CanonicalTrackName(config.Name.Value) {
+			continue
+		}
+
+		releasesResp, response, err := apiClient.SoftwareReleaseApi.ListReleasesOnTrack(ctx, accountId, trackId).Execute()
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError(fmt.Sprintf("Unable to list the releases on the database track %v", trackName), errMsg)
+			return
+		}
+		releases := make([]DatabaseRelease, 0)
+		for _, releaseData := range releasesResp.GetData() {
+			releases = append(releases, DatabaseRelease{
+				ReleaseID: types.String{Value: releaseData.Info.GetId()},
+				Version:   types.String{Value: releaseData.Spec.GetVersion()},
+				IsDefault: types.Bool{Value: releaseData.Spec.GetIsDefault()},
+			})
+		}
+		sort.SliceStable(releases, func(i, j int) bool {
+			return util.CompareDatabaseVersions(releases[i].Version.Value, releases[j].Version.Value) > 0
+		})
+
+		aliases := []types.String{}
+		for _, alias := range util.TrackAliases(trackName) {
+			aliases = append(aliases, types.String{Value: alias})
+		}
+		latestReleaseVersion := types.String{Null: true}
+		if len(releases) > 0 {
+			latestReleaseVersion = releases[0].Version
+		}
+		tracks = append(tracks, DatabaseTrack{
+			TrackID:              types.String{Value: trackId},
+			Name:                 types.String{Value: trackName},
+			Aliases:              aliases,
+			LatestReleaseVersion: latestReleaseVersion,
+			Releases:             releases,
+		})
+	}
+
+	if !config.Name.IsNull() && len(tracks) == 0 {
+		resp.Diagnostics.AddError("Unable to find the database track", fmt.Sprintf("The database track %v doesn't exist.", config.Name.Value))
+		return
+	}
+	sort.Slice(tracks, func(i, j int) bool {
+		return tracks[i].Name.Value < tracks[j].Name.Value
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Database Tracks Read: found %v tracks", len(tracks)))
+
+	config.AccountID = types.String{Value: accountId}
+	config.Tracks = tracks
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
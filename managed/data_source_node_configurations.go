@@ -0,0 +1,199 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+)
+
+type dataSourceNodeConfigurationsType struct{}
+
+func (r dataSourceNodeConfigurationsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The data source to fetch the node configurations supported in YugabyteDB Aeon for a cloud, tier and set of regions.
+The configurations are ordered by number of cores and memory, so the first one is the smallest that matches the filters.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"cloud_type": {
+				Description: "The cloud provider: AWS, AZURE or GCP.",
+				Type:        types.StringType,
+				Required:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "AZURE", "GCP")},
+			},
+			"cluster_tier": {
+				Description: "The tier of the cluster: FREE (Sandbox) or PAID (Dedicated). Defaults to PAID.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("FREE", "PAID")},
+			},
+			"regions": {
+				Description: "The regions to fetch the node configurations of. Duplicate regions are ignored.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Required:   true,
+				Validators: []tfsdk.AttributeValidator{listvalidator.SizeAtLeast(1), listvalidator.ValuesAre(stringvalidator.LengthAtLeast(1))},
+			},
+			"num_cores": {
+				Description: "Only return the node configurations with this number of CPU cores.",
+				Type:        types.Int64Type,
+				Optional:    true,
+			},
+			"min_memory_mb": {
+				Description: "Only return the node configurations with at least this much memory.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
+			},
+			"node_configurations": {
+				Description: "The node configurations matching the filters, ordered by region, number of cores and memory.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"region": {
+						Description: "The region the node configuration is available in.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"num_cores": {
+						Description: "Number of CPU cores of the node.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"memory_mb": {
+						Description: "Memory of the node in MB.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"included_disk_size_gb": {
+						Description: "Disk size included with the node, used when no disk size is configured.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"min_disk_size_gb": {
+						Description: "The smallest disk size the node can be configured with.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"min_disk_iops": {
+						Description: "The smallest disk IOPS the node can be configured with, from the catalogue or the default limits of the cloud when the catalogue has none. Null when the disk IOPS cannot be configured.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"max_disk_iops": {
+						Description: "The largest disk IOPS the node can be configured with, from the catalogue or the default limits of the cloud when the catalogue has none. Null when the disk IOPS cannot be configured.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceNodeConfigurationsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceNodeConfigurations{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceNodeConfigurations struct {
+	p provider
+}
+
+func (r dataSourceNodeConfigurations) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config NodeConfigurations
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ClusterTier.IsNull() {
+		config.ClusterTier = types.String{Value: "PAID"}
+	}
+
+	var regions []string
+	isListed := map[string]bool{}
+	for _, region := range config.Regions {
+		if !isListed[region.Value] {
+			isListed[region.Value] = true
+			regions = append(regions, region.Value)
+		}
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	catalog, catalogOK, message := r.p.nodeConfigCatalog.get(ctx, apiClient, accountId, config.CloudType.Value, config.ClusterTier.Value, regions)
+	if !catalogOK {
+		resp.Diagnostics.AddError("Unable to get the supported node configurations", message)
+		return
+	}
+
+	sort.Strings(regions)
+	nodeConfigurations := make([]NodeConfiguration, 0)
+	for _, region := range regions {
+		options := append([]util.NodeConfigOption{}, catalog[region]...)
+		sort.SliceStable(options, func(i, j int) bool {
+			if options[i].NumCores != options[j].NumCores {
+				return options[i].NumCores < options[j].NumCores
+			}
+			return options[i].MemoryMb < options[j].MemoryMb
+		})
+		for _, option := range options {
+			if !config.NumCores.IsNull() && option.NumCores != config.NumCores.Value {
+				continue
+			}
+			if !config.MinMemoryMb.IsNull() && option.MemoryMb < config.MinMemoryMb.Value {
+				continue
+			}
+			minDiskIops, maxDiskIops := types.Int64{Null: true}, types.Int64{Null: true}
+			if minIops, maxIops, iopsConfigurable := option.DiskIopsRange(config.CloudType.Value, config.ClusterTier.Value); iopsConfigurable {
+				minDiskIops, maxDiskIops = types.Int64{Value: minIops}, types.Int64{Value: maxIops}
+			}
+			nodeConfigurations = append(nodeConfigurations, NodeConfiguration{
+				Region:             types.String{Value: region},
+				NumCores:           types.Int64{Value: option.NumCores},
+				MemoryMb:           types.Int64{Value: option.MemoryMb},
+				IncludedDiskSizeGb: types.Int64{Value: option.IncludedDiskSizeGb},
+				MinDiskSizeGb:      types.Int64{Value: option.MinDiskSize(config.ClusterTier.Value)},
+				MinDiskIops:        minDiskIops,
+				MaxDiskIops:        maxDiskIops,
+			})
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Node Configurations Read: %v node configurations match the filters", len(nodeConfigurations)))
+
+	config.NodeConfigurations = nodeConfigurations
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
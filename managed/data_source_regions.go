@@ -0,0 +1,149 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type dataSourceRegionsType struct{}
+
+func (r dataSourceRegionsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The data source to fetch the regions supported in YugabyteDB Aeon for a cloud and tier.",
+		Attributes: map[string]tfsdk.Attribute{
+			"cloud_type": {
+				Description: "The cloud provider: AWS, AZURE or GCP.",
+				Type:        types.StringType,
+				Required:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "AZURE", "GCP")},
+			},
+			"cluster_tier": {
+				Description: "The tier of the cluster: FREE (Sandbox) or PAID (Dedicated). Defaults to PAID.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("FREE", "PAID")},
+			},
+			"country_code": {
+				Description: "Only return the regions in this country, for example US.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"name_regex": {
+				Description: "Only return the regions whose code or name matches this regular expression.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"regions": {
+				Description: "The regions matching the filters, ordered by region code.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"code": {
+						Description: "The region code, as used in the cluster_region_info of the cluster resource.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The display name of the region.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"country_code": {
+						Description: "The country the region is located in.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceRegionsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceRegions{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceRegions struct {
+	p provider
+}
+
+func (r dataSourceRegions) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config Regions
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ClusterTier.IsNull() {
+		config.ClusterTier = types.String{Value: "PAID"}
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	apiClient := r.p.client
+	regionsResp, response, err := apiClient.ClusterApi.GetSupportedCloudRegions(ctx).Cloud(config.CloudType.Value).Tier(config.ClusterTier.Value).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError("Unable to get the supported regions", errMsg)
+		return
+	}
+
+	regions := make([]Region, 0)
+	for _, region := range regionsResp.GetData() {
+		if !config.CountryCode.IsNull() && region.GetCountryCode() != config.CountryCode.Value {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(region.GetCode()) && !nameRegex.MatchString(region.GetName()) {
+			continue
+		}
+		regions = append(regions, Region{
+			Code:        types.String{Value: region.GetCode()},
+			Name:        types.String{Value: region.GetName()},
+			CountryCode: stringValueOrNull(region.GetCountryCode()),
+		})
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Code.Value < regions[j].Code.Value
+	})
+	tflog.Debug(ctx, fmt.Sprintf("Regions Read: %v regions match the filters", len(regions)))
+
+	config.Regions = regions
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
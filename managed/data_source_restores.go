@@ -0,0 +1,249 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type dataSourceRestoresType struct{}
+
+func (r dataSourceRestoresType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The data source to list the restores of the project, optionally filtered by cluster, backup and state.",
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account the restores belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project the restores belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"cluster_id": {
+				Description: "Only return the restores onto this cluster.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"backup_id": {
+				Description: "Only return the restores of this backup.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"state": {
+				Description: "Only return the restores in this state, for example SUCCEEDED or FAILED. The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"limit": {
+				Description: "The maximum number of restores to return, most recent first. All the matching restores are returned when not set.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
+			},
+			"restores": {
+				Description: "The restores matching the filters, most recent first.",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"restore_id": {
+						Description: "The ID of the restore.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"backup_id": {
+						Description: "The ID of the restored backup.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"cluster_id": {
+						Description: "The ID of the cluster the backup was restored onto.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"state": {
+						Description: "The state of the restore.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"start_time": {
+						Description: "The time the restore started.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"end_time": {
+						Description: "The time the restore completed. Null while the restore is running.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"duration_in_secs": {
+						Description: "How long the restore took. Null while the restore is running.",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"ysql_databases": {
+						Description: "The YSQL databases the restore selected. Null for a full restore, which restores all the databases of the backup without the API listing them.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"ycql_keyspaces": {
+						Description: "The YCQL keyspaces the restore selected. Null for a full restore, which restores all the keyspaces of the backup without the API listing them.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Computed: true,
+					},
+					"error_message": {
+						Description: "The reason the restore failed. Null unless the restore failed.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceRestoresType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceRestores{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceRestores struct {
+	p provider
+}
+
+func flattenRestore(data openapiclient.RestoreData) ListedRestore {
+	startTime := data.Info.Metadata.Get().GetCreatedOn()
+	endTime := data.Info.GetCompletedOn()
+
+	duration := types.Int64{Null: true}
+	if seconds, ok := util.DurationSeconds(startTime, endTime); ok {
+		duration = types.Int64{Value: seconds}
+	}
+
+	// A full restore has no selective spec. It restores all the namespaces of the backup, which the restore
+	// does not report, so they are left null rather than guessed.
+	var ysqlDatabases, ycqlKeyspaces []types.String
+	if selectiveRestoreSpec, ok := data.Spec.GetSelectiveRestoreSpecOk(); ok {
+		ysqlDatabases = []types.String{}
+		ycqlKeyspaces = []types.String{}
+		for _, database := range selectiveRestoreSpec.GetYsqlKeyspaces() {
+			ysqlDatabases = append(ysqlDatabases, types.String{Value: database})
+		}
+		for _, keyspace := range selectiveRestoreSpec.GetYcqlKeyspaces() {
+			ycqlKeyspaces = append(ycqlKeyspaces, types.String{Value: keyspace})
+		}
+	}
+
+	return ListedRestore{
+		RestoreID:      types.String{Value: data.Info.GetId()},
+		BackupID:       types.String{Value: data.Spec.GetBackupId()},
+		ClusterID:      types.String{Value: data.Spec.GetClusterId()},
+		State:          types.String{Value: string(data.Info.GetState())},
+		StartTime:      stringValueOrNull(startTime),
+		EndTime:        stringValueOrNull(endTime),
+		DurationInSecs: duration,
+		YSQLDatabases:  ysqlDatabases,
+		YCQLKeyspaces:  ycqlKeyspaces,
+		ErrorMessage:   stringValueOrNull(data.Info.GetErrorMessage()),
+	}
+}
+
+func (r dataSourceRestores) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config Restores
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	listRestores := func(continuationToken string) openapiclient.ApiListRestoresRequest {
+		apiRequest := apiClient.BackupApi.ListRestores(ctx, accountId, projectId)
+		if !config.ClusterID.IsNull() {
+			apiRequest = apiRequest.ClusterId(config.ClusterID.Value)
+		}
+		if !config.BackupID.IsNull() {
+			apiRequest = apiRequest.BackupId(config.BackupID.Value)
+		}
+		if continuationToken != "" {
+			apiRequest = apiRequest.ContinuationToken(continuationToken)
+		}
+		return apiRequest
+	}
+
+	restores := make([]ListedRestore, 0)
+	limitReached := func() bool {
+		return !config.Limit.IsNull() && int64(len(restores)) >= config.Limit.Value
+	}
+	restoresResp, response, err := listRestores("").Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError("Unable to list the restores", errMsg)
+			return
+		}
+		for _, data := range restoresResp.Data {
+			restore := flattenRestore(data)
+			if !config.State.IsNull() && !strings.EqualFold(restore.State.Value, config.State.Value) {
+				continue
+			}
+			restores = append(restores, restore)
+			if limitReached() {
+				break
+			}
+		}
+		if limitReached() || !restoresResp.Metadata.HasContinuationToken() {
+			break
+		}
+		restoresResp, response, err = listRestores(restoresResp.Metadata.GetContinuationToken()).Execute()
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Restores Read: %v restores match the filters", len(restores)))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.Restores = restores
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type dataSourceVPCPeeringType struct{}
+
+func (r dataSourceVPCPeeringType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := vpcPeeringComputedAttributes()
+	attributes["account_id"] = tfsdk.Attribute{
+		Description: "The ID of the account this VPC peering belongs to.",
+		Type:        types.StringType,
+		Computed:    true,
+	}
+	attributes["project_id"] = tfsdk.Attribute{
+		Description: "The ID of the project this VPC peering belongs to.",
+		Type:        types.StringType,
+		Computed:    true,
+	}
+	attributes["vpc_peering_id"] = tfsdk.Attribute{
+		Description: "The ID of the VPC peering. Used to get a specific VPC peering.",
+		Type:        types.StringType,
+		Computed:    true,
+		Optional:    true,
+	}
+	attributes["name"] = tfsdk.Attribute{
+		Description: "The name of the VPC peering. Used to get a specific VPC peering.",
+		Type:        types.StringType,
+		Computed:    true,
+		Optional:    true,
+	}
+
+	return tfsdk.Schema{
+		Description: `The data source to fetch a VPC peering in YugabyteDB Aeon by VPC peering name or ID.`,
+		Attributes:  attributes,
+	}, nil
+}
+
+func (r dataSourceVPCPeeringType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceVPCPeering{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceVPCPeering struct {
+	p provider
+}
+
+func (r dataSourceVPCPeering) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	// The computed application_vpc_info is null in the config, only the lookup attributes are read
+	var config VPCPeeringLookup
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vpc_peering_id"), &config.VPCPeeringID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &config.Name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exactly one parameter amongst name and vpc_peering_id must be present
+	namePresent := !config.Name.IsNull() && config.Name.Value != ""
+	idPresent := !config.VPCPeeringID.IsNull() && config.VPCPeeringID.Value != ""
+	if namePresent == idPresent {
+		resp.Diagnostics.AddError(
+			"Specify VPC peering name or VPC peering ID",
+			"To select a VPC peering, use either name or vpc_peering_id. Don't provide both.",
+		)
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	var vpcPeeringData openapiclient.VpcPeeringData
+	if idPresent {
+		vpcPeeringResp, response, err := apiClient.NetworkApi.GetVpcPeering(ctx, accountId, projectId, config.VPCPeeringID.Value).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read the VPC peering", getErrorMessage(response, err))
+			return
+		}
+		vpcPeeringData = vpcPeeringResp.GetData()
+	} else {
+		vpcPeeringList, err := listVPCPeerings(ctx, accountId, projectId, apiClient)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to list the VPC peerings", err.Error())
+			return
+		}
+		var matches []openapiclient.VpcPeeringData
+		for _, data := range vpcPeeringList {
+			if data.Spec.GetName() == config.Name.Value {
+				matches = append(matches, data)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError("Unable to read the VPC peering",
+				fmt.Sprintf("Expected exactly one VPC peering named %v, found %v.", config.Name.Value, len(matches)))
+			return
+		}
+		vpcPeeringData = matches[0]
+	}
+
+	vpcPeering := flattenListedVPCPeering(vpcPeeringData)
+	tflog.Debug(ctx, "VPC Peering Read: VPC peering on read from API server", map[string]interface{}{
+		"VPC Peering": vpcPeering})
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.VPCPeeringID = vpcPeering.VPCPeeringID
+	config.Name = vpcPeering.Name
+	config.YugabyteDBVPCID = vpcPeering.YugabyteDBVPCID
+	config.ApplicationVPCInfo = vpcPeering.ApplicationVPCInfo
+	config.VPCPeeringState = vpcPeering.VPCPeeringState
+	config.PeeringConnectionID = vpcPeering.PeeringConnectionID
+
+	diags := resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// vpcPeeringComputedAttributes are the attributes describing a VPC peering, shared by the
+// ybm_vpc_peering and ybm_vpc_peerings data sources.
+func vpcPeeringComputedAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"yugabytedb_vpc_id": {
+			Description: "The ID of the VPC where the YugabyteDB cluster is deployed.",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"application_vpc_info": {
+			Description: "The details for the VPC where the application is deployed.",
+			Computed:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"cloud": {
+					Description: "The cloud provider (AWS, AZURE or GCP) where the application is deployed.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+				"project": {
+					Description: "The project ID for GCP.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+				"account_id": {
+					Description: "The account ID for AWS.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+				"region": {
+					Description: "The region where the application is deployed.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+				"vpc_id": {
+					Description: "The ID of the VPC in which the application is deployed.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+				"cidr": {
+					Description: "The CIDR of the VPC in which the application is deployed.",
+					Type:        types.StringType,
+					Computed:    true,
+				},
+			}),
+		},
+		"vpc_peering_state": {
+			Description: "The state of the VPC peering.",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"peering_connection_id": {
+			Description: "The ID of the peering connection on the YugabyteDB side, which the application side must accept: the pcx- ID of the peering connection for AWS, the name of the peering of the YugabyteDB VPC network for GCP, the name of the peering of the YugabyteDB virtual network for Azure. Empty until the peering connection is created.",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+// vpcPeeringClouds are the clouds an application VPC can be in.
+var vpcPeeringClouds = []string{"AWS", "AZURE", "GCP"}
+
+// vpcPeeringStates are the states a VPC peering goes through.
+var vpcPeeringStates = []string{"CREATING", "PENDING", "ACTIVE", "FAILED", "EXPIRED", "DELETING", "DELETED"}
+
+type dataSourceVPCPeeringsType struct{}
+
+func (r dataSourceVPCPeeringsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	listedAttributes := vpcPeeringComputedAttributes()
+	listedAttributes["vpc_peering_id"] = tfsdk.Attribute{
+		Description: "The ID of the VPC peering.",
+		Type:        types.StringType,
+		Computed:    true,
+	}
+	listedAttributes["name"] = tfsdk.Attribute{
+		Description: "The name of the VPC peering.",
+		Type:        types.StringType,
+		Computed:    true,
+	}
+
+	return tfsdk.Schema{
+		Description: `The data source to fetch the VPC peerings in YugabyteDB Aeon, optionally filtered by VPC, cloud and state.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account the VPC peerings belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project the VPC peerings belong to.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"yugabytedb_vpc_id": {
+				Description: "Only return the VPC peerings of this YugabyteDB VPC.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"cloud": {
+				Description: "Only return the VPC peerings with an application VPC in this cloud: " + strings.Join(vpcPeeringClouds, ", ") + ". The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOfCaseInsensitive(vpcPeeringClouds...)},
+			},
+			"state": {
+				Description: "Only return the VPC peerings in this state: " + strings.Join(vpcPeeringStates, ", ") + ". The comparison is case insensitive.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOfCaseInsensitive(vpcPeeringStates...)},
+			},
+			"vpc_peerings": {
+				Description: "The VPC peerings matching all the given filters.",
+				Computed:    true,
+				Attributes:  tfsdk.ListNestedAttributes(listedAttributes),
+			},
+		},
+	}, nil
+}
+
+func (r dataSourceVPCPeeringsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceVPCPeerings{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceVPCPeerings struct {
+	p provider
+}
+
+func (r dataSourceVPCPeerings) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var config VPCPeerings
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get the project ID", message)
+		return
+	}
+
+	vpcPeeringList, err := listVPCPeerings(ctx, accountId, projectId, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to list the VPC peerings", err.Error())
+		return
+	}
+
+	vpcPeerings := make([]ListedVPCPeering, 0)
+	for _, vpcPeeringData := range vpcPeeringList {
+		vpcPeering := flattenListedVPCPeering(vpcPeeringData)
+		if vpcPeeringMatchesFilters(vpcPeering, config) {
+			vpcPeerings = append(vpcPeerings, vpcPeering)
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("VPC Peerings Read: %v of %v VPC peerings match the filters", len(vpcPeerings), len(vpcPeeringList)))
+
+	config.AccountID = types.String{Value: accountId}
+	config.ProjectID = types.String{Value: projectId}
+	config.VPCPeerings = vpcPeerings
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// vpcPeeringMatchesFilters reports whether the VPC peering matches all the filters set in the configuration.
+// The cloud and the state are compared case insensitively.
+func vpcPeeringMatchesFilters(vpcPeering ListedVPCPeering, config VPCPeerings) bool {
+	if !config.YugabyteDBVPCID.IsNull() && vpcPeering.YugabyteDBVPCID.Value != config.YugabyteDBVPCID.Value {
+		return false
+	}
+	if !config.Cloud.IsNull() && !strings.EqualFold(vpcPeering.ApplicationVPCInfo.Cloud.Value, config.Cloud.Value) {
+		return false
+	}
+	if !config.State.IsNull() && !strings.EqualFold(vpcPeering.VPCPeeringState.Value, config.State.Value) {
+		return false
+	}
+	return true
+}
+
+func listVPCPeerings(ctx context.Context, accountId string, projectId string, apiClient *openapiclient.APIClient) ([]openapiclient.VpcPeeringData, error) {
+	vpcPeeringsResp, response, err := apiClient.NetworkApi.ListVpcPeerings(ctx, accountId, projectId).Execute()
+	if err != nil {
+		return nil, errors.New(getErrorMessage(response, err))
+	}
+	return vpcPeeringsResp.GetData(), nil
+}
+
+func flattenListedVPCPeering(vpcPeeringData openapiclient.VpcPeeringData) ListedVPCPeering {
+	return ListedVPCPeering{
+		VPCPeeringID:        types.String{Value: vpcPeeringData.Info.GetId()},
+		Name:                types.String{Value: vpcPeeringData.Spec.GetName()},
+		YugabyteDBVPCID:     types.String{Value: vpcPeeringData.Spec.GetInternalYugabyteVpcId()},
+		ApplicationVPCInfo:  flattenApplicationVPCInfo(vpcPeeringData.Spec.CustomerVpc),
+		VPCPeeringState:     types.String{Value: string(vpcPeeringData.Info.GetState())},
+		PeeringConnectionID: types.String{Value: vpcPeeringData.Info.GetPeeringConnectionId()},
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVPCPeeringMatchesFilters(t *testing.T) {
+	vpcPeering := ListedVPCPeering{
+		VPCPeeringID:       types.String{Value: "test-vpc-peering-id"},
+		Name:               types.String{Value: "app-peering"},
+		YugabyteDBVPCID:    types.String{Value: "test-vpc-id"},
+		ApplicationVPCInfo: ApplicationVPCInfo{Cloud: types.String{Value: "AWS"}},
+		VPCPeeringState:    types.String{Value: "ACTIVE"},
+	}
+	notSet := types.String{Null: true}
+
+	testCases := []struct {
+		TestName         string
+		YugabyteDBVPCID  types.String
+		Cloud            types.String
+		State            types.String
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "No filters",
+			YugabyteDBVPCID:  notSet,
+			Cloud:            notSet,
+			State:            notSet,
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "All filters match",
+			YugabyteDBVPCID:  types.String{Value: "test-vpc-id"},
+			Cloud:            types.String{Value: "AWS"},
+			State:            types.String{Value: "ACTIVE"},
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Lower case cloud and state",
+			YugabyteDBVPCID:  notSet,
+			Cloud:            types.String{Value: "aws"},
+			State:            types.String{Value: "active"},
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Other VPC",
+			YugabyteDBVPCID:  types.String{Value: "test-other-vpc-id"},
+			Cloud:            notSet,
+			State:            notSet,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Other cloud",
+			YugabyteDBVPCID:  notSet,
+			Cloud:            types.String{Value: "gcp"},
+			State:            notSet,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Other state",
+			YugabyteDBVPCID:  notSet,
+			Cloud:            notSet,
+			State:            types.String{Value: "PENDING"},
+			ExpectedResponse: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			config := VPCPeerings{
+				YugabyteDBVPCID: testCase.YugabyteDBVPCID,
+				Cloud:           testCase.Cloud,
+				State:           testCase.State,
+			}
+			gotResponse := vpcPeeringMatchesFilters(vpcPeering, config)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("vpcPeeringMatchesFilters(%v, %v) = %v; want %v", vpcPeering, config, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
@@ -19,13 +19,14 @@ type Cluster struct {
 	FaultTolerance                types.String           `tfsdk:"fault_tolerance"`
 	NumFaultsToTolerate           types.Int64            `tfsdk:"num_faults_to_tolerate"`
 	IsMultiCloud                  types.Bool             `tfsdk:"is_multi_cloud"`
-	ClusterRegionInfo             []RegionInfo           `tfsdk:"cluster_region_info"`
+	ClusterRegionInfo             map[string]RegionInfo  `tfsdk:"cluster_region_info"`
 	DatabaseTrack                 types.String           `tfsdk:"database_track"`
 	DesiredState                  types.String           `tfsdk:"desired_state"`
 	DesiredConnectionPoolingState types.String           `tfsdk:"desired_connection_pooling_state"`
 	ClusterTier                   types.String           `tfsdk:"cluster_tier"`
 	ClusterAllowListIDs           []types.String         `tfsdk:"cluster_allow_list_ids"`
 	RestoreBackupID               types.String           `tfsdk:"restore_backup_id"`
+	CloneFrom                     *CloneFrom             `tfsdk:"clone_from"`
 	NodeConfig                    *NodeConfig            `tfsdk:"node_config"`
 	Credentials                   *Credentials           `tfsdk:"credentials"`
 	ClusterInfo                   ClusterInfo            `tfsdk:"cluster_info"`
@@ -36,6 +37,167 @@ type Cluster struct {
 	ClusterCertificate            types.String           `tfsdk:"cluster_certificate"`
 	CMKSpec                       *CMKSpec               `tfsdk:"cmk_spec"`
 	BackupReplicationSpec         *BackupReplicationSpec `tfsdk:"backup_replication_spec"`
+	StorageAutoscaling            *StorageAutoscaling    `tfsdk:"storage_autoscaling"`
+}
+
+type CloneFrom struct {
+	SourceClusterID     types.String          `tfsdk:"source_cluster_id"`
+	MostRecent          types.Bool            `tfsdk:"most_recent"`
+	Timestamp           types.String          `tfsdk:"timestamp"`
+	BackupID            types.String          `tfsdk:"backup_id"`
+	RestoredBackupID    types.String          `tfsdk:"restored_backup_id"`
+	UseRoles            types.Bool            `tfsdk:"use_roles"`
+	YSQLDatabases       []types.String        `tfsdk:"ysql_databases"`
+	YCQLKeyspaces       []types.String        `tfsdk:"ycql_keyspaces"`
+	YSQLDatabasesRename []DatabaseRenameBlock `tfsdk:"ysql_databases_rename"`
+	YCQLKeyspacesRename []DatabaseRenameBlock `tfsdk:"ycql_keyspaces_rename"`
+}
+
+type StorageAutoscaling struct {
+	Enabled             types.Bool  `tfsdk:"enabled"`
+	ThresholdPercentage types.Int64 `tfsdk:"threshold_percentage"`
+	IncrementGb         types.Int64 `tfsdk:"increment_gb"`
+	MaxDiskSizeGb       types.Int64 `tfsdk:"max_disk_size_gb"`
+}
+
+type Clusters struct {
+	AccountID     types.String     `tfsdk:"account_id"`
+	ProjectID     types.String     `tfsdk:"project_id"`
+	NameRegex     types.String     `tfsdk:"name_regex"`
+	CloudType     types.String     `tfsdk:"cloud_type"`
+	ClusterTier   types.String     `tfsdk:"cluster_tier"`
+	State         types.String     `tfsdk:"state"`
+	Region        types.String     `tfsdk:"region"`
+	DatabaseTrack types.String     `tfsdk:"database_track"`
+	Clusters      []ClusterSummary `tfsdk:"clusters"`
+}
+
+type ClusterSummary struct {
+	ClusterID           types.String   `tfsdk:"cluster_id"`
+	ClusterName         types.String   `tfsdk:"cluster_name"`
+	CloudType           types.String   `tfsdk:"cloud_type"`
+	ClusterType         types.String   `tfsdk:"cluster_type"`
+	ClusterTier         types.String   `tfsdk:"cluster_tier"`
+	State               types.String   `tfsdk:"state"`
+	FaultTolerance      types.String   `tfsdk:"fault_tolerance"`
+	NumFaultsToTolerate types.Int64    `tfsdk:"num_faults_to_tolerate"`
+	NumNodes            types.Int64    `tfsdk:"num_nodes"`
+	Regions             []types.String `tfsdk:"regions"`
+	DatabaseTrack       types.String   `tfsdk:"database_track"`
+	SoftwareVersion     types.String   `tfsdk:"software_version"`
+	CreatedTime         types.String   `tfsdk:"created_time"`
+}
+
+type ClusterTasks struct {
+	AccountID       types.String  `tfsdk:"account_id"`
+	ProjectID       types.String  `tfsdk:"project_id"`
+	ClusterID       types.String  `tfsdk:"cluster_id"`
+	TaskType        types.String  `tfsdk:"task_type"`
+	State           types.String  `tfsdk:"state"`
+	Limit           types.Int64   `tfsdk:"limit"`
+	HasRunningTasks types.Bool    `tfsdk:"has_running_tasks"`
+	Tasks           []ClusterTask `tfsdk:"tasks"`
+}
+
+type ClusterTask struct {
+	TaskID       types.String `tfsdk:"task_id"`
+	TaskType     types.String `tfsdk:"task_type"`
+	EntityType   types.String `tfsdk:"entity_type"`
+	State        types.String `tfsdk:"state"`
+	StartTime    types.String `tfsdk:"start_time"`
+	EndTime      types.String `tfsdk:"end_time"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
+
+type ClusterNamespaces struct {
+	AccountID     types.String       `tfsdk:"account_id"`
+	ProjectID     types.String       `tfsdk:"project_id"`
+	ClusterID     types.String       `tfsdk:"cluster_id"`
+	NamespaceType types.String       `tfsdk:"namespace_type"`
+	Namespaces    []ClusterNamespace `tfsdk:"namespaces"`
+	YsqlDatabases []types.String     `tfsdk:"ysql_databases"`
+	YcqlKeyspaces []types.String     `tfsdk:"ycql_keyspaces"`
+}
+
+type ClusterNamespace struct {
+	NamespaceID   types.String `tfsdk:"namespace_id"`
+	NamespaceName types.String `tfsdk:"namespace_name"`
+	NamespaceType types.String `tfsdk:"namespace_type"`
+}
+
+type DatabaseTracks struct {
+	AccountID types.String    `tfsdk:"account_id"`
+	Name      types.String    `tfsdk:"name"`
+	Tracks    []DatabaseTrack `tfsdk:"tracks"`
+}
+
+type DatabaseTrack struct {
+	TrackID              types.String      `tfsdk:"track_id"`
+	Name                 types.String      `tfsdk:"name"`
+	Aliases              []types.String    `tfsdk:"aliases"`
+	LatestReleaseVersion types.String      `tfsdk:"latest_release_version"`
+	Releases             []DatabaseRelease `tfsdk:"releases"`
+}
+
+type DatabaseRelease struct {
+	ReleaseID types.String `tfsdk:"release_id"`
+	Version   types.String `tfsdk:"version"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+}
+
+type NodeConfigurations struct {
+	CloudType          types.String        `tfsdk:"cloud_type"`
+	ClusterTier        types.String        `tfsdk:"cluster_tier"`
+	Regions            []types.String      `tfsdk:"regions"`
+	NumCores           types.Int64         `tfsdk:"num_cores"`
+	MinMemoryMb        types.Int64         `tfsdk:"min_memory_mb"`
+	NodeConfigurations []NodeConfiguration `tfsdk:"node_configurations"`
+}
+
+type NodeConfiguration struct {
+	Region             types.String `tfsdk:"region"`
+	NumCores           types.Int64  `tfsdk:"num_cores"`
+	MemoryMb           types.Int64  `tfsdk:"memory_mb"`
+	IncludedDiskSizeGb types.Int64  `tfsdk:"included_disk_size_gb"`
+	MinDiskSizeGb      types.Int64  `tfsdk:"min_disk_size_gb"`
+	MinDiskIops        types.Int64  `tfsdk:"min_disk_iops"`
+	MaxDiskIops        types.Int64  `tfsdk:"max_disk_iops"`
+}
+
+type Regions struct {
+	CloudType   types.String `tfsdk:"cloud_type"`
+	ClusterTier types.String `tfsdk:"cluster_tier"`
+	CountryCode types.String `tfsdk:"country_code"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Regions     []Region     `tfsdk:"regions"`
+}
+
+type Region struct {
+	Code        types.String `tfsdk:"code"`
+	Name        types.String `tfsdk:"name"`
+	CountryCode types.String `tfsdk:"country_code"`
+}
+
+type ClusterConnection struct {
+	AccountID       types.String                `tfsdk:"account_id"`
+	ProjectID       types.String                `tfsdk:"project_id"`
+	ClusterID       types.String                `tfsdk:"cluster_id"`
+	Username        types.String                `tfsdk:"username"`
+	Database        types.String                `tfsdk:"database"`
+	SSLRootCertPath types.String                `tfsdk:"ssl_root_cert_path"`
+	CACertificate   types.String                `tfsdk:"ca_certificate"`
+	Connections     []ClusterConnectionEndpoint `tfsdk:"connections"`
+}
+
+type ClusterConnectionEndpoint struct {
+	AccessibilityType     types.String `tfsdk:"accessibility_type"`
+	Region                types.String `tfsdk:"region"`
+	Host                  types.String `tfsdk:"host"`
+	YSQLURI               types.String `tfsdk:"ysql_uri"`
+	JDBCURL               types.String `tfsdk:"jdbc_url"`
+	YCQLContactPoint      types.String `tfsdk:"ycql_contact_point"`
+	LoadBalanceParams     types.String `tfsdk:"load_balance_params"`
+	JDBCLoadBalanceParams types.String `tfsdk:"jdbc_load_balance_params"`
 }
 
 type ClusterEndpoint struct {
@@ -52,6 +214,21 @@ type CMKSpec struct {
 	IsEnabled    types.Bool    `tfsdk:"is_enabled"`
 }
 
+type ClusterCMK struct {
+	AccountID       types.String  `tfsdk:"account_id"`
+	ProjectID       types.String  `tfsdk:"project_id"`
+	ClusterID       types.String  `tfsdk:"cluster_id"`
+	ProviderType    types.String  `tfsdk:"provider_type"`
+	IsEnabled       types.Bool    `tfsdk:"is_enabled"`
+	AWSCMKSpec      *AWSCMKSpec   `tfsdk:"aws_cmk_spec"`
+	GCPCMKSpec      *GCPCMKSpec   `tfsdk:"gcp_cmk_spec"`
+	AzureCMKSpec    *AzureCMKSpec `tfsdk:"azure_cmk_spec"`
+	RotationTrigger types.String  `tfsdk:"rotation_trigger"`
+	Status          types.String  `tfsdk:"status"`
+	ActiveKey       types.String  `tfsdk:"active_key"`
+	LastRotatedOn   types.String  `tfsdk:"last_rotated_on"`
+}
+
 type AWSCMKSpec struct {
 	AccessKey types.String   `tfsdk:"access_key"`
 	SecretKey types.String   `tfsdk:"secret_key"`
@@ -87,6 +264,70 @@ type GCPServiceAccount struct {
 	ClientX509CertUrl       types.String `tfsdk:"client_x509_cert_url"`
 	UniverseDomain          types.String `tfsdk:"universe_domain"`
 }
+type Backups struct {
+	AccountID           types.String   `tfsdk:"account_id"`
+	ProjectID           types.String   `tfsdk:"project_id"`
+	ClusterID           types.String   `tfsdk:"cluster_id"`
+	State               types.String   `tfsdk:"state"`
+	BackupType          types.String   `tfsdk:"backup_type"`
+	CreatedAfter        types.String   `tfsdk:"created_after"`
+	CreatedBefore       types.String   `tfsdk:"created_before"`
+	DescriptionContains types.String   `tfsdk:"description_contains"`
+	Backups             []ListedBackup `tfsdk:"backups"`
+}
+
+type ListedBackup struct {
+	BackupID              types.String   `tfsdk:"backup_id"`
+	ClusterID             types.String   `tfsdk:"cluster_id"`
+	State                 types.String   `tfsdk:"state"`
+	BackupType            types.String   `tfsdk:"backup_type"`
+	BackupDescription     types.String   `tfsdk:"backup_description"`
+	CreatedOn             types.String   `tfsdk:"created_on"`
+	CompletedOn           types.String   `tfsdk:"completed_on"`
+	DurationInSecs        types.Int64    `tfsdk:"duration_in_secs"`
+	ExpiresOn             types.String   `tfsdk:"expires_on"`
+	RetentionPeriodInDays types.Int64    `tfsdk:"retention_period_in_days"`
+	SizeInBytes           types.Int64    `tfsdk:"size_in_bytes"`
+	IncludedDatabases     []types.String `tfsdk:"included_databases"`
+}
+
+type Restores struct {
+	AccountID types.String    `tfsdk:"account_id"`
+	ProjectID types.String    `tfsdk:"project_id"`
+	ClusterID types.String    `tfsdk:"cluster_id"`
+	BackupID  types.String    `tfsdk:"backup_id"`
+	State     types.String    `tfsdk:"state"`
+	Limit     types.Int64     `tfsdk:"limit"`
+	Restores  []ListedRestore `tfsdk:"restores"`
+}
+
+type ListedRestore struct {
+	RestoreID      types.String   `tfsdk:"restore_id"`
+	BackupID       types.String   `tfsdk:"backup_id"`
+	ClusterID      types.String   `tfsdk:"cluster_id"`
+	State          types.String   `tfsdk:"state"`
+	StartTime      types.String   `tfsdk:"start_time"`
+	EndTime        types.String   `tfsdk:"end_time"`
+	DurationInSecs types.Int64    `tfsdk:"duration_in_secs"`
+	YSQLDatabases  []types.String `tfsdk:"ysql_databases"`
+	YCQLKeyspaces  []types.String `tfsdk:"ycql_keyspaces"`
+	ErrorMessage   types.String   `tfsdk:"error_message"`
+}
+
+type BackupSchedule struct {
+	AccountID                 types.String `tfsdk:"account_id"`
+	ProjectID                 types.String `tfsdk:"project_id"`
+	ClusterID                 types.String `tfsdk:"cluster_id"`
+	ScheduleID                types.String `tfsdk:"schedule_id"`
+	State                     types.String `tfsdk:"state"`
+	CronExpression            types.String `tfsdk:"cron_expression"`
+	TimeIntervalInDays        types.Int64  `tfsdk:"time_interval_in_days"`
+	RetentionPeriodInDays     types.Int64  `tfsdk:"retention_period_in_days"`
+	IncrementalIntervalInMins types.Int64  `tfsdk:"incremental_interval_in_mins"`
+	BackupDescription         types.String `tfsdk:"backup_description"`
+	UseRoles                  types.Bool   `tfsdk:"use_roles"`
+}
+
 type BackupScheduleInfo struct {
 	State                     types.String `tfsdk:"state"`
 	RetentionPeriodInDays     types.Int64  `tfsdk:"retention_period_in_days"`
@@ -103,6 +344,7 @@ type RegionInfo struct {
 	NumCores                   types.Int64  `tfsdk:"num_cores"`
 	NumZones                   types.Int64  `tfsdk:"num_zones"`
 	DiskSizeGb                 types.Int64  `tfsdk:"disk_size_gb"`
+	CurrentDiskSizeGb          types.Int64  `tfsdk:"current_disk_size_gb"`
 	DiskIops                   types.Int64  `tfsdk:"disk_iops"`
 	VPCID                      types.String `tfsdk:"vpc_id"`
 	VPCName                    types.String `tfsdk:"vpc_name"`
@@ -117,6 +359,22 @@ type BackupReplicationSpec struct {
 	GCPSpec *GcpBackupReplicationSpec `tfsdk:"gcp_spec"`
 }
 
+type ClusterBackupRegion struct {
+	AccountID     types.String   `tfsdk:"account_id"`
+	ProjectID     types.String   `tfsdk:"project_id"`
+	ClusterID     types.String   `tfsdk:"cluster_id"`
+	BackupRegions []types.String `tfsdk:"backup_regions"`
+}
+
+type BackupReplication struct {
+	AccountID          types.String              `tfsdk:"account_id"`
+	ProjectID          types.String              `tfsdk:"project_id"`
+	ClusterID          types.String              `tfsdk:"cluster_id"`
+	ClusterType        types.String              `tfsdk:"cluster_type"`
+	HasFailedTransfers types.Bool                `tfsdk:"has_failed_transfers"`
+	GCPSpec            *GcpBackupReplicationSpec `tfsdk:"gcp_spec"`
+}
+
 type GcpBackupReplicationSpec struct {
 	Enabled                   types.Bool                           `tfsdk:"enabled"`
 	SyncClusterSpec           *SyncClusterGcpBackupReplicationSpec `tfsdk:"sync_cluster_spec"`
@@ -211,6 +469,13 @@ type AllowList struct {
 	ClusterIDs           []types.String `tfsdk:"cluster_ids"`
 }
 
+type ClusterAllowListAttachment struct {
+	AccountID   types.String `tfsdk:"account_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	AllowListID types.String `tfsdk:"allow_list_id"`
+}
+
 type Backup struct {
 	AccountID             types.String `tfsdk:"account_id"`
 	ProjectID             types.String `tfsdk:"project_id"`
@@ -223,6 +488,19 @@ type Backup struct {
 	UseRoles              types.Bool   `tfsdk:"use_roles"`
 }
 
+type BackupResource struct {
+	AccountID             types.String `tfsdk:"account_id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	ClusterID             types.String `tfsdk:"cluster_id"`
+	BackupID              types.String `tfsdk:"backup_id"`
+	BackupDescription     types.String `tfsdk:"backup_description"`
+	RetentionPeriodInDays types.Int64  `tfsdk:"retention_period_in_days"`
+	MostRecent            types.Bool   `tfsdk:"most_recent"`
+	Timestamp             types.String `tfsdk:"timestamp"`
+	UseRoles              types.Bool   `tfsdk:"use_roles"`
+	RetainOnDestroy       types.Bool   `tfsdk:"retain_on_destroy"`
+}
+
 type BackupRestore struct {
 	AccountID           types.String          `tfsdk:"account_id"`
 	ProjectID           types.String          `tfsdk:"project_id"`
@@ -277,13 +555,47 @@ type ReadReplicaInfo struct {
 }
 
 type VPCPeering struct {
-	AccountID          types.String       `tfsdk:"account_id"`
-	ProjectID          types.String       `tfsdk:"project_id"`
-	Name               types.String       `tfsdk:"name"`
-	VPCPeeringID       types.String       `tfsdk:"vpc_peering_id"`
-	YugabyteDBVPCID    types.String       `tfsdk:"yugabytedb_vpc_id"`
-	ApplicationVPCInfo ApplicationVPCInfo `tfsdk:"application_vpc_info"`
-	VPCPeeringState    types.String       `tfsdk:"vpc_peering_state"`
+	AccountID                  types.String       `tfsdk:"account_id"`
+	ProjectID                  types.String       `tfsdk:"project_id"`
+	Name                       types.String       `tfsdk:"name"`
+	VPCPeeringID               types.String       `tfsdk:"vpc_peering_id"`
+	YugabyteDBVPCID            types.String       `tfsdk:"yugabytedb_vpc_id"`
+	ApplicationVPCInfo         ApplicationVPCInfo `tfsdk:"application_vpc_info"`
+	VPCPeeringState            types.String       `tfsdk:"vpc_peering_state"`
+	PeeringConnectionID        types.String       `tfsdk:"peering_connection_id"`
+	ApplicationRouteCIDRs      []types.String     `tfsdk:"application_route_cidrs"`
+	YugabyteDBExternalVPCID    types.String       `tfsdk:"yugabytedb_external_vpc_id"`
+	WaitForActive              types.Bool         `tfsdk:"wait_for_active"`
+	WaitForActiveTimeoutInMins types.Int64        `tfsdk:"wait_for_active_timeout_in_mins"`
+}
+
+type VPCPeeringLookup struct {
+	AccountID           types.String       `tfsdk:"account_id"`
+	ProjectID           types.String       `tfsdk:"project_id"`
+	VPCPeeringID        types.String       `tfsdk:"vpc_peering_id"`
+	Name                types.String       `tfsdk:"name"`
+	YugabyteDBVPCID     types.String       `tfsdk:"yugabytedb_vpc_id"`
+	ApplicationVPCInfo  ApplicationVPCInfo `tfsdk:"application_vpc_info"`
+	VPCPeeringState     types.String       `tfsdk:"vpc_peering_state"`
+	PeeringConnectionID types.String       `tfsdk:"peering_connection_id"`
+}
+
+type VPCPeerings struct {
+	AccountID       types.String       `tfsdk:"account_id"`
+	ProjectID       types.String       `tfsdk:"project_id"`
+	YugabyteDBVPCID types.String       `tfsdk:"yugabytedb_vpc_id"`
+	Cloud           types.String       `tfsdk:"cloud"`
+	State           types.String       `tfsdk:"state"`
+	VPCPeerings     []ListedVPCPeering `tfsdk:"vpc_peerings"`
+}
+
+type ListedVPCPeering struct {
+	VPCPeeringID        types.String       `tfsdk:"vpc_peering_id"`
+	Name                types.String       `tfsdk:"name"`
+	YugabyteDBVPCID     types.String       `tfsdk:"yugabytedb_vpc_id"`
+	ApplicationVPCInfo  ApplicationVPCInfo `tfsdk:"application_vpc_info"`
+	VPCPeeringState     types.String       `tfsdk:"vpc_peering_state"`
+	PeeringConnectionID types.String       `tfsdk:"peering_connection_id"`
 }
 
 type ApplicationVPCInfo struct {
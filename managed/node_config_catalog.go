@@ -0,0 +1,107 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+// nodeConfigCatalog caches the supported node configurations of each cloud, tier and region, so
+// that plan and apply fetch the catalogue of a region once. It is shared by all copies of the
+// provider, hence it is always used through a pointer.
+type nodeConfigCatalog struct {
+	mu      sync.Mutex
+	entries map[string][]util.NodeConfigOption
+}
+
+func newNodeConfigCatalog() *nodeConfigCatalog {
+	return &nodeConfigCatalog{
+		entries: map[string][]util.NodeConfigOption{},
+	}
+}
+
+func nodeConfigCatalogKey(cloud string, tier string, region string) string {
+	return cloud + "|" + tier + "|" + region
+}
+
+// get returns the node configurations of each of the given regions, keyed by region. The
+// regions missing from the cache are fetched with a single request. A nil catalog fetches the
+// configurations without caching them.
+func (c *nodeConfigCatalog) get(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, cloud string, tier string, regions []string) (map[string][]util.NodeConfigOption, bool, string) {
+	entry := map[string][]util.NodeConfigOption{}
+	if c != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	}
+
+	var missingRegions []string
+	for _, region := range regions {
+		if c != nil {
+			if options, ok := c.entries[nodeConfigCatalogKey(cloud, tier, region)]; ok {
+				entry[region] = options
+				continue
+			}
+		}
+		missingRegions = append(missingRegions, region)
+	}
+	if len(missingRegions) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Using cached node configurations for %v %v in %v", cloud, tier, strings.Join(regions, ", ")))
+		return entry, true, ""
+	}
+
+	instanceResp, resp, err := apiClient.ClusterApi.GetSupportedNodeConfigurationsByAccount(ctx, accountId).Cloud(cloud).Tier(tier).Regions(missingRegions).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(resp, err)
+		return nil, false, errMsg
+	}
+
+	instanceData := instanceResp.GetData()
+	for _, region := range missingRegions {
+		options := []util.NodeConfigOption{}
+		for _, nodeConfig := range instanceData[region] {
+			options = append(options, util.NodeConfigOption{
+				NumCores:           int64(nodeConfig.GetNumCores()),
+				MemoryMb:           int64(nodeConfig.GetMemoryMb()),
+				IncludedDiskSizeGb: int64(nodeConfig.GetIncludedDiskSizeGb()),
+				MinDiskSizeGb:      int64(nodeConfig.GetMinDiskSizeGb()),
+				MinDiskIops:        int64(nodeConfig.GetMinDiskIops()),
+				MaxDiskIops:        int64(nodeConfig.GetMaxDiskIops()),
+			})
+		}
+		entry[region] = options
+		if c != nil {
+			c.entries[nodeConfigCatalogKey(cloud, tier, region)] = options
+		}
+	}
+	return entry, true, ""
+}
+
+// getNodeConfigOption returns the node configuration with the given number of cores in a region.
+func (c *nodeConfigCatalog) getNodeConfigOption(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, cloud string, tier string, region string, numCores int32) (option util.NodeConfigOption, optionOK bool, errorMessage string) {
+	catalog, catalogOK, message := c.get(ctx, apiClient, accountId, cloud, tier, []string{region})
+	if !catalogOK {
+		return util.NodeConfigOption{}, false, message
+	}
+	nodeConfigList := catalog[region]
+	if len(nodeConfigList) == 0 {
+		return util.NodeConfigOption{}, false, "No instances configured for the given region."
+	}
+	for _, nodeConfig := range nodeConfigList {
+		if nodeConfig.NumCores == int64(numCores) {
+			return nodeConfig, true, ""
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Could not find a instance with %v cores in %v cloud in the region %v", numCores, cloud, region))
+
+	return util.NodeConfigOption{}, false, "Node with the given number of CPU cores doesn't exist in the given region."
+}
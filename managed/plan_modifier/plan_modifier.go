@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ImmutableFieldModifier is a plan modifier that enforces immutability of an attribute.
@@ -39,3 +41,30 @@ func (m ImmutableFieldModifier) Description(ctx context.Context) string {
 func (m ImmutableFieldModifier) MarkdownDescription(ctx context.Context) string {
 	return "Errors if the field is changed after resource creation"
 }
+
+// MapKeyModifier is a plan modifier that plans an unknown string attribute of a map nested
+// attribute as the key of the map element it belongs to. This is used for attributes that
+// repeat the key, so that adding an element plans the attribute instead of showing it as
+// known after apply.
+type MapKeyModifier struct{}
+
+func (m MapKeyModifier) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	if resp.AttributePlan == nil || !resp.AttributePlan.IsUnknown() {
+		return
+	}
+
+	elementStep, _ := req.AttributePath.ParentPath().Steps().LastStep()
+	key, ok := elementStep.(path.PathStepElementKeyString)
+	if !ok {
+		return
+	}
+	resp.AttributePlan = types.String{Value: string(key)}
+}
+
+func (m MapKeyModifier) Description(ctx context.Context) string {
+	return "Plans an unknown value as the key of the enclosing map element"
+}
+
+func (m MapKeyModifier) MarkdownDescription(ctx context.Context) string {
+	return "Plans an unknown value as the key of the enclosing map element"
+}
@@ -20,15 +20,17 @@ var stderr = os.Stderr
 func New(version string) func() tfsdk.Provider {
 	return func() tfsdk.Provider {
 		return &provider{
-			version: version,
+			version:           version,
+			nodeConfigCatalog: newNodeConfigCatalog(),
 		}
 	}
 }
 
 type provider struct {
-	version    string
-	configured bool
-	client     *openapiclient.APIClient
+	version           string
+	configured        bool
+	client            *openapiclient.APIClient
+	nodeConfigCatalog *nodeConfigCatalog
 }
 
 func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -145,9 +147,13 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	resources := map[string]tfsdk.ResourceType{
 		"ybm_cluster":                            resourceClusterType{},
+		"ybm_cluster_cmk":                        resourceClusterCMKType{},
+		"ybm_cluster_backup_region":              resourceClusterBackupRegionType{},
 		"ybm_allow_list":                         resourceAllowListType{},
+		"ybm_cluster_allow_list_attachment":      resourceClusterAllowListAttachmentType{},
 		"ybm_backup":                             resourceBackupType{},
 		"ybm_backup_restore":                     resourceBackupRestoreType{},
+		"ybm_backup_schedule":                    resourceBackupScheduleType{},
 		"ybm_vpc":                                resourceVPCType{},
 		"ybm_read_replicas":                      resourceReadReplicasType{},
 		"ybm_vpc_peering":                        resourceVPCPeeringType{},
@@ -179,12 +185,24 @@ func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceTyp
 
 func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	dataSources := map[string]tfsdk.DataSourceType{
-		"ybm_backup":           dataSourceBackupType{},
-		"ybm_cluster":          dataClusterNameType{},
-		"ybm_vpc":              dataSourceVPCType{},
-		"ybm_allow_list":       dataSourceAllowListType{},
-		"ybm_integration":      dataSourceIntegrationType{},
-		"ybm_db_audit_logging": dataSourceDbAuditLoggingType{},
+		"ybm_backup":              dataSourceBackupType{},
+		"ybm_backup_replication":  dataSourceBackupReplicationType{},
+		"ybm_backups":             dataSourceBackupsType{},
+		"ybm_cluster":             dataClusterNameType{},
+		"ybm_clusters":            dataSourceClustersType{},
+		"ybm_cluster_connection":  dataSourceClusterConnectionType{},
+		"ybm_cluster_namespaces":  dataSourceClusterNamespacesType{},
+		"ybm_cluster_tasks":       dataSourceClusterTasksType{},
+		"ybm_node_configurations": dataSourceNodeConfigurationsType{},
+		"ybm_database_tracks":     dataSourceDatabaseTracksType{},
+		"ybm_restores":            dataSourceRestoresType{},
+		"ybm_regions":             dataSourceRegionsType{},
+		"ybm_vpc":                 dataSourceVPCType{},
+		"ybm_vpc_peering":         dataSourceVPCPeeringType{},
+		"ybm_vpc_peerings":        dataSourceVPCPeeringsType{},
+		"ybm_allow_list":          dataSourceAllowListType{},
+		"ybm_integration":         dataSourceIntegrationType{},
+		"ybm_db_audit_logging":    dataSourceDbAuditLoggingType{},
 	}
 
 	return dataSources, nil
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	retry "github.com/sethvargo/go-retry"
 	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	"github.com/yugabyte/terraform-provider-ybm/managed/validation"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -24,7 +26,7 @@ type resourceAllowListType struct{}
 
 func (r resourceAllowListType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		Description: `The resource to create an allow list in YugabyteDB Aeon.`,
+		Description: `The resource to create an allow list in YugabyteDB Aeon. Changes to the CIDR list or the description are applied without detaching the allow list from its clusters: a copy of the allow list with the new rules is attached to the clusters before the old allow list is removed. The allow list therefore gets a new allow_list_id.`,
 		Attributes: map[string]tfsdk.Attribute{
 			"account_id": {
 				Description: "The ID of the account this allow list belongs to.",
@@ -50,18 +52,16 @@ func (r resourceAllowListType) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
 			},
 			"allow_list_description": {
-				Description:   "The description of the allow list.",
-				Type:          types.StringType,
-				Optional:      true,
-				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+				Description: "The description of the allow list.",
+				Type:        types.StringType,
+				Optional:    true,
 			},
 			"cidr_list": {
-				Description: "The CIDR list of the allow list.",
+				Description: "The CIDR list of the allow list. A single IP address is allowed as well. Equivalent notations, such as 10.0.0.1 and 10.0.0.1/32, are considered the same.",
 				Type: types.SetType{
 					ElemType: types.StringType,
 				},
-				Required:      true,
-				PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+				Required: true,
 			},
 			"cluster_ids": {
 				Description: "List of the IDs of the clusters the allow list is assigned to.",
@@ -84,6 +84,36 @@ type resourceAllowList struct {
 	p provider
 }
 
+var _ tfsdk.ResourceWithValidateConfig = resourceAllowList{}
+
+func (r resourceAllowList) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var cidrSet types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cidr_list"), &cidrSet)...)
+	if resp.Diagnostics.HasError() || cidrSet.IsNull() || cidrSet.IsUnknown() {
+		return
+	}
+
+	// Two notations of the same range would be stored once, leaving a perpetual diff
+	normalizedCIDRs := map[string]string{}
+	for _, element := range cidrSet.Elems {
+		cidr, ok := element.(types.String)
+		if !ok || cidr.IsNull() || cidr.IsUnknown() {
+			continue
+		}
+		normalizedCIDR, err := validation.NormalizeCIDR(cidr.Value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cidr_list"), "Invalid CIDR", err.Error())
+			continue
+		}
+		if otherCIDR, found := normalizedCIDRs[normalizedCIDR]; found {
+			resp.Diagnostics.AddAttributeError(path.Root("cidr_list"), "Duplicate CIDR",
+				fmt.Sprintf("%v and %v are the same range %v, remove one of them.", otherCIDR, cidr.Value, normalizedCIDR))
+			continue
+		}
+		normalizedCIDRs[normalizedCIDR] = cidr.Value
+	}
+}
+
 func getAllowListPlan(ctx context.Context, plan tfsdk.Plan, allowList *AllowList) diag.Diagnostics {
 	// NOTE: currently must manually fill out each attribute due to usage of Go structs
 	// Once the opt-in conversion of null or unknown values to the empty value is implemented, this can all be replaced with req.Plan.Get(ctx, &allowList)
@@ -143,10 +173,7 @@ func (r resourceAllowList) Create(ctx context.Context, req tfsdk.CreateResourceR
 
 	allowListName := plan.AllowListName.Value
 	allowListDesc := plan.AllowListDescription.Value
-	cidrList := []string{}
-	for i := range plan.CIDRList {
-		cidrList = append(cidrList, plan.CIDRList[i].Value)
-	}
+	cidrList := normalizeCIDRList(util.SliceTypesStringToSliceString(plan.CIDRList))
 
 	allowListListResp, _, err := apiClient.NetworkApi.ListNetworkAllowLists(ctx, accountId, projectId).Execute()
 	if err != nil {
@@ -160,12 +187,9 @@ func (r resourceAllowList) Create(ctx context.Context, req tfsdk.CreateResourceR
 		return
 	}
 
-	networkAllowListSpec := *openapiclient.NewNetworkAllowListSpec(allowListName, allowListDesc, cidrList) // NetworkAllowListSpec | Allow list specification (optional)
-
-	_, response, err := apiClient.NetworkApi.CreateNetworkAllowList(ctx, accountId, projectId).NetworkAllowListSpec(networkAllowListSpec).Execute()
+	_, err = createNetworkAllowList(ctx, accountId, projectId, allowListName, allowListDesc, cidrList, apiClient)
 	if err != nil {
-		errMsg := getErrorMessage(response, err)
-		resp.Diagnostics.AddError("Unable to create allow list ", errMsg)
+		resp.Diagnostics.AddError("Unable to create allow list ", err.Error())
 		return
 	}
 
@@ -174,6 +198,7 @@ func (r resourceAllowList) Create(ctx context.Context, req tfsdk.CreateResourceR
 		resp.Diagnostics.AddError("Unable to read the state of the allow list ", message)
 		return
 	}
+	allowList.CIDRList = keepConfiguredCIDRNotation(allowList.CIDRList, plan.CIDRList)
 	tflog.Debug(ctx, "Allow List Create: Allow list on read from API server", map[string]interface{}{
 		"Allow List": allowList})
 
@@ -260,6 +285,7 @@ func (r resourceAllowList) Read(ctx context.Context, req tfsdk.ReadResourceReque
 		resp.Diagnostics.AddError("Unable to read the state of the allow list ", message)
 		return
 	}
+	allowList.CIDRList = keepConfiguredCIDRNotation(allowList.CIDRList, state.CIDRList)
 	tflog.Debug(ctx, "Allow List Read: Allow list on read from API server", map[string]interface{}{
 		"Allow List": allowList})
 
@@ -271,11 +297,141 @@ func (r resourceAllowList) Read(ctx context.Context, req tfsdk.ReadResourceReque
 }
 
 // Update allow list
+// The API cannot edit an allow list, so the allow list is replaced. An interim copy with the new rules is created
+// first and, when the allow list is attached to clusters, attached to them while the allow list is recreated under
+// its name, so that no cluster is ever left without the rules. The old allow list is only deleted once the interim
+// copy is in place.
 func (r resourceAllowList) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan AllowList
+	resp.Diagnostics.Append(getAllowListPlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "Error while getting the plan for the allow list")
+		return
+	}
+	var state AllowList
+	getIDsFromAllowListState(ctx, req.State, &state)
+	accountId := state.AccountID.Value
+	projectId := state.ProjectID.Value
+	allowListId := state.AllowListID.Value
+	allowListName := plan.AllowListName.Value
+	allowListDesc := plan.AllowListDescription.Value
+	cidrList := normalizeCIDRList(util.SliceTypesStringToSliceString(plan.CIDRList))
+	interimName := allowListName + "-update"
+
+	apiClient := r.p.client
+
+	// On failure the state holds whichever of the allow lists exists at that point: the allow list itself, old or
+	// new, or the interim copy when the allow list could not be recreated.
+	setExistingAllowListState := func() {
+		configuredCIDRs := append(append([]types.String{}, plan.CIDRList...), state.CIDRList...)
+		for _, name := range []string{allowListName, interimName} {
+			allowList, readOK, _ := resourceAllowListRead(accountId, projectId, name, apiClient)
+			if readOK {
+				allowList.CIDRList = keepConfiguredCIDRNotation(allowList.CIDRList, configuredCIDRs)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &allowList)...)
+				return
+			}
+		}
+	}
+	// Detaches the interim copy from the clusters again and deletes it, while the old allow list still exists.
+	rollback := func(interimId string, clusterIds []string) {
+		if len(clusterIds) > 0 {
+			err := swapClusterAllowList(ctx, accountId, projectId, clusterIds, interimId, allowListId, apiClient)
+			if err != nil {
+				resp.Diagnostics.AddWarning("Unable to roll back the allow list update",
+					fmt.Sprintf("The interim allow list %s may still be attached to clusters %v instead of the allow list %s: %s", interimName, clusterIds, allowListName, err))
+				return
+			}
+		}
+		_, err := apiClient.NetworkApi.DeleteNetworkAllowList(ctx, accountId, projectId, interimId).Execute()
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to roll back the allow list update",
+				fmt.Sprintf("Delete the interim allow list %s before updating the allow list again: %s", interimName, GetApiErrorDetails(err)))
+		}
+	}
+
+	allowListResp, response, err := apiClient.NetworkApi.GetNetworkAllowList(ctx, accountId, projectId, allowListId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError("Unable to update the allow list ", errMsg)
+		return
+	}
+	clusterIds := allowListResp.Data.Info.ClusterIds
+
+	allowListListResp, response, err := apiClient.NetworkApi.ListNetworkAllowLists(ctx, accountId, projectId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the allow list ", getErrorMessage(response, err))
+		return
+	}
+	err = findDuplicateNetworkAllowList(allowListListResp.GetData(), interimName)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the allow list ", fmt.Sprintf("The interim allow list cannot be created: %s", err))
+		return
+	}
+
+	interimId, err := createNetworkAllowList(ctx, accountId, projectId, interimName, allowListDesc, cidrList, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the allow list ", err.Error())
+		return
+	}
+	if len(clusterIds) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Attaching the interim allow list %s to clusters %v", interimName, clusterIds))
+		err = swapClusterAllowList(ctx, accountId, projectId, clusterIds, allowListId, interimId, apiClient)
+		if err != nil {
+			rollback(interimId, clusterIds)
+			setExistingAllowListState()
+			resp.Diagnostics.AddError("Unable to update the allow list ", err.Error())
+			return
+		}
+	}
+	_, err = apiClient.NetworkApi.DeleteNetworkAllowList(ctx, accountId, projectId, allowListId).Execute()
+	if err != nil {
+		rollback(interimId, clusterIds)
+		setExistingAllowListState()
+		resp.Diagnostics.AddError("Unable to update the allow list ", GetApiErrorDetails(err))
+		return
+	}
 
-	resp.Diagnostics.AddError("Unable to update allow list", "Updating allow lists is not currently supported. Delete and recreate the provider.")
-	return
+	// The old allow list is gone from here on, a failure leaves the interim copy with the new rules in its place
+	newAllowListId, err := createNetworkAllowList(ctx, accountId, projectId, allowListName, allowListDesc, cidrList, apiClient)
+	if err != nil {
+		setExistingAllowListState()
+		resp.Diagnostics.AddError("Unable to update the allow list ",
+			fmt.Sprintf("%s. The new rules are in the interim allow list %s, which replaces the allow list in the state.", err, interimName))
+		return
+	}
+	if len(clusterIds) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Attaching the updated allow list %s to clusters %v", allowListName, clusterIds))
+		err = swapClusterAllowList(ctx, accountId, projectId, clusterIds, interimId, newAllowListId, apiClient)
+		if err != nil {
+			setExistingAllowListState()
+			resp.Diagnostics.AddError("Unable to update the allow list ",
+				fmt.Sprintf("%s. The interim allow list %s may still be attached to clusters %v, detach and delete it before updating the allow list again.", err, interimName, clusterIds))
+			return
+		}
+	}
+	_, err = apiClient.NetworkApi.DeleteNetworkAllowList(ctx, accountId, projectId, interimId).Execute()
+	if err != nil {
+		setExistingAllowListState()
+		resp.Diagnostics.AddError("Unable to delete the interim allow list ",
+			fmt.Sprintf("%s. Delete the allow list %s before updating the allow list again.", GetApiErrorDetails(err), interimName))
+		return
+	}
+
+	allowList, readOK, message := resourceAllowListRead(accountId, projectId, allowListName, apiClient)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the state of the allow list ", message)
+		return
+	}
+	allowList.CIDRList = keepConfiguredCIDRNotation(allowList.CIDRList, plan.CIDRList)
+	tflog.Debug(ctx, "Allow List Update: Allow list on read from API server", map[string]interface{}{
+		"Allow List": allowList})
 
+	diags := resp.State.Set(ctx, &allowList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete allow list
@@ -356,11 +512,85 @@ func getNetworkAllowListIdByName(ctx context.Context, accountId string, projectI
 
 }
 
+// normalizeCIDRList returns the canonical notation of the entries of an allow list. Entries that cannot be
+// parsed are passed on as they are, for the API to report them.
+func normalizeCIDRList(cidrList []string) []string {
+	normalizedList := []string{}
+	for _, cidr := range cidrList {
+		if normalizedCIDR, err := validation.NormalizeCIDR(cidr); err == nil {
+			cidr = normalizedCIDR
+		}
+		normalizedList = append(normalizedList, cidr)
+	}
+	return normalizedList
+}
+
+// keepConfiguredCIDRNotation returns the CIDRs read from the API in the notation of the equivalent configured
+// CIDRs, so that e.g. a configured 10.0.0.1 read back as 10.0.0.1/32 does not show as a change.
+func keepConfiguredCIDRNotation(readCIDRs []types.String, configuredCIDRs []types.String) []types.String {
+	cidrList := []types.String{}
+	for _, readCIDR := range readCIDRs {
+		for _, configuredCIDR := range configuredCIDRs {
+			if !configuredCIDR.IsNull() && !configuredCIDR.IsUnknown() && validation.EquivalentCIDRs(readCIDR.Value, configuredCIDR.Value) {
+				readCIDR = configuredCIDR
+				break
+			}
+		}
+		cidrList = append(cidrList, readCIDR)
+	}
+	return cidrList
+}
+
+func createNetworkAllowList(ctx context.Context, accountId string, projectId string, name string, description string, cidrList []string, apiClient *openapiclient.APIClient) (string, error) {
+	networkAllowListSpec := *openapiclient.NewNetworkAllowListSpec(name, description, cidrList) // NetworkAllowListSpec | Allow list specification (optional)
+
+	allowListResp, response, err := apiClient.NetworkApi.CreateNetworkAllowList(ctx, accountId, projectId).NetworkAllowListSpec(networkAllowListSpec).Execute()
+	if err != nil {
+		return "", errors.New(getErrorMessage(response, err))
+	}
+	return allowListResp.Data.Info.Id, nil
+}
+
+// swapClusterAllowList attaches the allow list toId to every cluster before detaching fromId, so the clusters
+// are never without either of them.
+func swapClusterAllowList(ctx context.Context, accountId string, projectId string, clusterIds []string, fromId string, toId string, apiClient *openapiclient.APIClient) error {
+	for _, clusterId := range clusterIds {
+		err := addAllowListToCluster(ctx, accountId, projectId, clusterId, toId, apiClient)
+		if err != nil {
+			return err
+		}
+		err = removeAllowListFromCluster(ctx, accountId, projectId, clusterId, fromId, apiClient)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addAllowListToCluster(ctx context.Context, accountId string, projectId string, clusterId string, allowListId string, apiClient *openapiclient.APIClient) error {
+	return editClusterAllowLists(ctx, accountId, projectId, clusterId, func(allowListIds []string) []string {
+		for _, id := range allowListIds {
+			if id == allowListId {
+				return allowListIds
+			}
+		}
+		return append(allowListIds, allowListId)
+	}, apiClient)
+}
+
 func removeAllowListFromCluster(ctx context.Context, accountId string, projectId string, clusterId string, allowListId string, apiClient *openapiclient.APIClient) error {
+	return editClusterAllowLists(ctx, accountId, projectId, clusterId, func(allowListIds []string) []string {
+		return util.Filter(allowListIds, func(id string) bool {
+			return id != allowListId
+		})
+	}, apiClient)
+}
+
+func editClusterAllowLists(ctx context.Context, accountId string, projectId string, clusterId string, edit func(allowListIds []string) []string, apiClient *openapiclient.APIClient) error {
 
 	clusterResp, resp, err := apiClient.ClusterApi.GetCluster(ctx, accountId, projectId, clusterId).Execute()
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			tflog.Debug(ctx, fmt.Sprintf("Cluster %s does not exist anymore or cannot be found ", clusterId))
 			return nil
 
@@ -373,28 +603,44 @@ func removeAllowListFromCluster(ctx context.Context, accountId string, projectId
 		return nil
 	}
 
-	//First we gather allowList for the cluster
-	clusterNalResp, r, err := apiClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountId, projectId, clusterId).Execute()
-	if err != nil {
-		//Cluster could have been deleted
-		if r.StatusCode == 404 {
-			return nil
+	// The allow lists of the cluster are read and written back as a whole. When another edit of the cluster is
+	// in progress the write is rejected, so the allow lists are read again and the edit retried.
+	clusterDeleted := false
+	conflictRetryPolicy := retry.NewConstant(10 * time.Second)
+	conflictRetryPolicy = retry.WithMaxDuration(600*time.Second, conflictRetryPolicy)
+	err = retry.Do(ctx, conflictRetryPolicy, func(ctx context.Context) error {
+		clusterNalResp, r, err := apiClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountId, projectId, clusterId).Execute()
+		if err != nil {
+			//Cluster could have been deleted
+			if r != nil && r.StatusCode == 404 {
+				clusterDeleted = true
+				return nil
+			}
+			return fmt.Errorf("unable to check network allow list for cluster %s: %s", clusterId, GetApiErrorDetails(err))
 		}
-		return fmt.Errorf("unable to check network allow list for cluster %s: %s", clusterId, GetApiErrorDetails(err))
-	}
-	allowList := util.Filter(clusterNalResp.GetData(), func(ep openapiclient.NetworkAllowListData) bool {
-		return ep.GetInfo().Id != allowListId
-	})
 
-	allowListIds := []string{}
-	for _, v := range allowList {
-		allowListIds = append(allowListIds, v.GetInfo().Id)
+		allowListIds := []string{}
+		for _, v := range clusterNalResp.GetData() {
+			allowListIds = append(allowListIds, v.GetInfo().Id)
 
-	}
+		}
+		allowListIds = edit(allowListIds)
 
-	_, _, err = apiClient.ClusterApi.EditClusterNetworkAllowLists(ctx, accountId, projectId, clusterId).RequestBody(allowListIds).Execute()
+		_, r, err = apiClient.ClusterApi.EditClusterNetworkAllowLists(ctx, accountId, projectId, clusterId).RequestBody(allowListIds).Execute()
+		if err != nil {
+			if r != nil && r.StatusCode == http.StatusConflict {
+				tflog.Debug(ctx, fmt.Sprintf("Another edit of cluster %s is in progress, retrying the allow list edit", clusterId))
+				return retry.RetryableError(errors.New("another edit of the cluster is in progress"))
+			}
+			return fmt.Errorf("unable to edit network allow list for cluster %s: %s", clusterId, GetApiErrorDetails(err))
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("unable to edit network allow list for cluster %s: %s", clusterId, GetApiErrorDetails(err))
+		return err
+	}
+	if clusterDeleted {
+		return nil
 	}
 
 	retryPolicy := retry.NewConstant(10 * time.Second)
@@ -411,7 +657,7 @@ func removeAllowListFromCluster(ctx context.Context, accountId string, projectId
 		} else {
 			return retry.RetryableError(errors.New("unable to check edit network allow list for cluster : " + message))
 		}
-		return retry.RetryableError(errors.New("allow list is being de-associated from the cluster"))
+		return retry.RetryableError(errors.New("allow lists of the cluster are being edited"))
 	})
 
 	if err != nil {
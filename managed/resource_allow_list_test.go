@@ -6,12 +6,13 @@ package managed
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"reflect"
 	"testing"
 
 	gomock "github.com/golang/mock/gomock"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	mocks "github.com/yugabyte/terraform-provider-ybm/mock_yugabytedb_managed_go_client_internal"
@@ -81,6 +82,66 @@ func getDeleteAllowListRequest(ctx context.Context, cfg *openapiclient.Configura
 	return &deleteAllowListRequest
 }
 
+func getAttachedAllowListResponse(allowListID string, projectID string, cidrList []string, allowListDescription string, allowListName string, clusterIDs []string) *openapiclient.NetworkAllowListResponse {
+	allowListResponse := getCreateAllowListResponse(allowListID, projectID, cidrList, allowListDescription, allowListName)
+	allowListResponse.Data.Info.ClusterIds = clusterIDs
+	return allowListResponse
+}
+
+func getClusterAllowListsResponse(projectID string, allowListIDs ...string) openapiclient.NetworkAllowListListResponse {
+	allowLists := []openapiclient.NetworkAllowListData{}
+	for _, allowListID := range allowListIDs {
+		allowLists = append(allowLists, getCreateAllowListResponse(allowListID, projectID, []string{"0.0.0.0/0"}, "", allowListID).Data)
+	}
+	allowListsResponse := openapiclient.NewNetworkAllowListListResponseWithDefaults()
+	allowListsResponse.SetData(allowLists)
+	return *allowListsResponse
+}
+
+func getTaskListResponse(t *testing.T, taskType string, state string) openapiclient.TaskListResponse {
+	taskListResponse := openapiclient.NewTaskListResponseWithDefaults()
+	body := `{"data": [{"info": {"task_type": "` + taskType + `", "state": "` + state + `"}}]}`
+	if err := json.Unmarshal([]byte(body), taskListResponse); err != nil {
+		t.Fatalf("Unable to build the task list response: %v", err)
+	}
+	return *taskListResponse
+}
+
+// expectClusterAllowListEdit records the calls made to change the allow lists of a cluster from attachedIDs to
+// editedIDs, and to wait for the EDIT_ALLOW_LIST task of the cluster. The calls are returned in the order they
+// are made.
+func expectClusterAllowListEdit(t *testing.T, ctx context.Context, cfg *openapiclient.Configuration, accountID string, projectID string, clusterID string, attachedIDs []string, editedIDs []string, editErr error, mockClusterApi *mocks.MockClusterApi, mockTaskApi *mocks.MockTaskApi) []*gomock.Call {
+	testClient := openapiclient.NewAPIClient(cfg)
+	getClusterRequest := testClient.ClusterApi.GetCluster(ctx, accountID, projectID, clusterID)
+	getClusterRequest.ApiService = mockClusterApi
+	listClusterAllowListsRequest := testClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID)
+	listClusterAllowListsRequest.ApiService = mockClusterApi
+	editClusterAllowListsRequest := testClient.ClusterApi.EditClusterNetworkAllowLists(ctx, accountID, projectID, clusterID)
+	editClusterAllowListsRequest.ApiService = mockClusterApi
+	listTasksRequest := testClient.TaskApi.ListTasks(ctx, accountID)
+	listTasksRequest.ApiService = mockTaskApi
+	httpSuccessResponse := &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+	}
+
+	calls := []*gomock.Call{
+		mockClusterApi.EXPECT().GetCluster(ctx, accountID, projectID, clusterID).Return(getClusterRequest).Times(1),
+		mockClusterApi.EXPECT().GetClusterExecute(getClusterRequest).Return(openapiclient.ClusterResponse{}, httpSuccessResponse, nil).Times(1),
+		mockClusterApi.EXPECT().ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID).Return(listClusterAllowListsRequest).Times(1),
+		mockClusterApi.EXPECT().ListClusterNetworkAllowListsExecute(listClusterAllowListsRequest).Return(getClusterAllowListsResponse(projectID, attachedIDs...), httpSuccessResponse, nil).Times(1),
+		mockClusterApi.EXPECT().EditClusterNetworkAllowLists(ctx, accountID, projectID, clusterID).Return(editClusterAllowListsRequest).Times(1),
+	}
+	if editErr != nil {
+		return append(calls,
+			mockClusterApi.EXPECT().EditClusterNetworkAllowListsExecute(editClusterAllowListsRequest.RequestBody(editedIDs)).Return(openapiclient.NetworkAllowListListResponse{}, nil, editErr).Times(1))
+	}
+	return append(calls,
+		mockClusterApi.EXPECT().EditClusterNetworkAllowListsExecute(editClusterAllowListsRequest.RequestBody(editedIDs)).Return(getClusterAllowListsResponse(projectID, editedIDs...), httpSuccessResponse, nil).Times(1),
+		mockTaskApi.EXPECT().ListTasks(ctx, accountID).Return(listTasksRequest).Times(1),
+		mockTaskApi.EXPECT().ListTasksExecute(listTasksRequest.TaskType(openapiclient.TASKTYPEENUM_EDIT_ALLOW_LIST).ProjectId(projectID).EntityId(clusterID).Limit(1)).Return(getTaskListResponse(t, "EDIT_ALLOW_LIST", "SUCCEEDED"), httpSuccessResponse, nil).Times(1))
+}
+
 func TestCreateAllowList(t *testing.T) {
 
 	mockCtrl := gomock.NewController(t)
@@ -265,29 +326,355 @@ func TestUpdateAllowList(t *testing.T) {
 	mockAccountApi := mocks.NewMockAccountApi(mockCtrl)
 	ctx := context.Background()
 	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	cidrList := []string{"0.0.0.0/0"}
+	cidrListSchema := []types.String{{Value: "0.0.0.0/0"}}
+	updatedCidrList := []string{"10.0.0.0/8", "192.168.0.0/16"}
+	updatedCidrListSchema := []types.String{{Value: "10.0.0.0/8"}, {Value: "192.168.0.0/16"}}
+	allowListName := "office"
+	interimAllowListName := "office-update"
+	allowListDescription := "Office network"
+	allowListID := "test-allow-list-id"
+	interimAllowListID := "test-interim-allow-list-id"
+	updatedAllowListID := "test-updated-allow-list-id"
 	allowList := getMockAllowList(cfg, mockNetworkApi, mockProjectApi, mockAccountApi)
+
+	getAllowListRequest := getGetAllowListRequest(ctx, cfg, accountID, projectID, allowListID, mockNetworkApi)
+	getAllowListResponse := getCreateAllowListResponse(allowListID, projectID, cidrList, allowListDescription, allowListName)
+	deleteAllowListRequest := getDeleteAllowListRequest(ctx, cfg, accountID, projectID, allowListID, mockNetworkApi)
+	createAllowListRequest := getCreateAllowListRequest(ctx, cfg, accountID, projectID, mockNetworkApi)
+	createInterimAllowListRequestFinal := createAllowListRequest.NetworkAllowListSpec(*openapiclient.NewNetworkAllowListSpec(interimAllowListName, allowListDescription, updatedCidrList))
+	createInterimAllowListResponse := getCreateAllowListResponse(interimAllowListID, projectID, updatedCidrList, allowListDescription, interimAllowListName)
+	createAllowListRequestFinal := createAllowListRequest.NetworkAllowListSpec(*openapiclient.NewNetworkAllowListSpec(allowListName, allowListDescription, updatedCidrList))
+	createAllowListResponse := getCreateAllowListResponse(updatedAllowListID, projectID, updatedCidrList, allowListDescription, allowListName)
+	deleteInterimAllowListRequest := getDeleteAllowListRequest(ctx, cfg, accountID, projectID, interimAllowListID, mockNetworkApi)
+	getUpdatedAllowListRequest := getGetAllowListRequest(ctx, cfg, accountID, projectID, updatedAllowListID, mockNetworkApi)
+	getInterimAllowListRequest := getGetAllowListRequest(ctx, cfg, accountID, projectID, interimAllowListID, mockNetworkApi)
+	listNetworkAllowListsRequest := getListAllowListRequest(ctx, cfg, accountID, projectID, mockNetworkApi)
+	listNetworkAllowListsResponseBefore := getListAllowListResponse(allowListID, projectID, cidrList, allowListDescription, allowListName)
+	listNetworkAllowListsResponse := getListAllowListResponse(updatedAllowListID, projectID, updatedCidrList, allowListDescription, allowListName)
+	listNetworkAllowListsResponseInterim := getListAllowListResponse(interimAllowListID, projectID, updatedCidrList, allowListDescription, interimAllowListName)
+
+	allowListType := resourceAllowListType{}
+	schema, _ := allowListType.GetSchema(ctx)
 	req := tfsdk.UpdateResourceRequest{}
-	resp := &tfsdk.UpdateResourceResponse{}
-	diags := diag.Diagnostics{}
-	diags.AddError("Unable to update allow list", "Updating allow lists is not currently supported. Delete and recreate the provider.")
+	req.State.Schema = schema
+	req.State.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             cidrListSchema,
+		AllowListID:          types.String{Value: allowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           []types.String{},
+	})
+	req.Plan.Schema = schema
+	req.Plan.Set(ctx, &AllowList{
+		AccountID:            types.String{Unknown: true},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             updatedCidrListSchema,
+		AllowListID:          types.String{Unknown: true},
+		ProjectID:            types.String{Unknown: true},
+		ClusterIDs:           nil,
+	})
+
+	updatedState := tfsdk.State{}
+	updatedState.Schema = schema
+	updatedState.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             updatedCidrListSchema,
+		AllowListID:          types.String{Value: updatedAllowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           []types.String{},
+	})
+	oldState := tfsdk.State{}
+	oldState.Schema = schema
+	oldState.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             cidrListSchema,
+		AllowListID:          types.String{Value: allowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           []types.String{},
+	})
+	interimState := tfsdk.State{}
+	interimState.Schema = schema
+	interimState.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: interimAllowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             updatedCidrListSchema,
+		AllowListID:          types.String{Value: interimAllowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           []types.String{},
+	})
+
+	httpSuccessResponse := &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+	}
+	apiError := errors.New("500 Internal Server Error")
 
 	testCases := []struct {
-		TestName            string
-		ExpectedDiagnostics diag.Diagnostics
+		TestName        string
+		SetExpectations func()
+		ExpectedState   tfsdk.State
+		ExpectedErrors  bool
 	}{
 		{
-			TestName:            "Update allow list",
-			ExpectedDiagnostics: diags,
+			TestName: "Allow list not attached to any cluster",
+			SetExpectations: func() {
+				mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*deleteAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteAllowListRequest).Return(httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().CreateNetworkAllowList(ctx, accountID, projectID).Return(*createAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().CreateNetworkAllowListExecute(createAllowListRequestFinal).Return(*createAllowListResponse, httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, interimAllowListID).Return(*deleteInterimAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteInterimAllowListRequest).Return(httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1)
+				mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponse, httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, updatedAllowListID).Return(*getUpdatedAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getUpdatedAllowListRequest).Return(*createAllowListResponse, httpSuccessResponse, nil).Times(1)
+			},
+			ExpectedState: updatedState,
+		},
+		{
+			TestName: "Old allow list cannot be deleted",
+			SetExpectations: func() {
+				mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*deleteAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteAllowListRequest).Return(nil, apiError).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, interimAllowListID).Return(*deleteInterimAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteInterimAllowListRequest).Return(httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1)
+				mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponseBefore, httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*getAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getAllowListRequest).Return(*getAllowListResponse, httpSuccessResponse, nil).Times(1)
+			},
+			ExpectedState:  oldState,
+			ExpectedErrors: true,
+		},
+		{
+			TestName: "Allow list cannot be recreated",
+			SetExpectations: func() {
+				mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*deleteAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteAllowListRequest).Return(httpSuccessResponse, nil).Times(1)
+				mockNetworkApi.EXPECT().CreateNetworkAllowList(ctx, accountID, projectID).Return(*createAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().CreateNetworkAllowListExecute(createAllowListRequestFinal).Return(openapiclient.NetworkAllowListResponse{}, nil, apiError).Times(1)
+				mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(2)
+				mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponseInterim, httpSuccessResponse, nil).Times(2)
+				mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, interimAllowListID).Return(*getInterimAllowListRequest).Times(1)
+				mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getInterimAllowListRequest).Return(*createInterimAllowListResponse, httpSuccessResponse, nil).Times(1)
+			},
+			ExpectedState:  interimState,
+			ExpectedErrors: true,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.TestName, func(t *testing.T) {
+			resp := &tfsdk.UpdateResourceResponse{}
+			resp.State.Schema = schema
 
+			// The interim allow list is always created first
+			mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*getAllowListRequest).Times(1)
+			mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getAllowListRequest).Return(*getAllowListResponse, httpSuccessResponse, nil).Times(1)
+			mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1)
+			mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponseBefore, httpSuccessResponse, nil).Times(1)
+			mockNetworkApi.EXPECT().CreateNetworkAllowList(ctx, accountID, projectID).Return(*createAllowListRequest).Times(1)
+			mockNetworkApi.EXPECT().CreateNetworkAllowListExecute(createInterimAllowListRequestFinal).Return(*createInterimAllowListResponse, httpSuccessResponse, nil).Times(1)
+			testCase.SetExpectations()
 			allowList.Update(ctx, req, resp)
 
-			if !reflect.DeepEqual(resp.Diagnostics, testCase.ExpectedDiagnostics) {
-				t.Errorf("Got Diagnostics: %v, Expected Diagnostics: %v", resp.Diagnostics, testCase.ExpectedDiagnostics)
+			if resp.Diagnostics.HasError() != testCase.ExpectedErrors {
+				t.Errorf("Got errors: %v, Expected errors: %v", resp.Diagnostics, testCase.ExpectedErrors)
+			}
+			if !reflect.DeepEqual(resp.State, testCase.ExpectedState) {
+				t.Errorf("Got State: %v, Expected State: %v", resp.State, testCase.ExpectedState)
+			}
+		})
+
+	}
+}
+
+func TestUpdateAttachedAllowList(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockNetworkApi := mocks.NewMockNetworkApi(mockCtrl)
+	mockProjectApi := mocks.NewMockProjectApi(mockCtrl)
+	mockAccountApi := mocks.NewMockAccountApi(mockCtrl)
+	mockClusterApi := mocks.NewMockClusterApi(mockCtrl)
+	mockTaskApi := mocks.NewMockTaskApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	clusterID := "test-cluster-id"
+	clusterIDs := []string{clusterID}
+	clusterIDsSchema := []types.String{{Value: clusterID}}
+	cidrList := []string{"0.0.0.0/0"}
+	cidrListSchema := []types.String{{Value: "0.0.0.0/0"}}
+	updatedCidrList := []string{"10.0.0.0/8", "192.168.0.0/16"}
+	updatedCidrListSchema := []types.String{{Value: "10.0.0.0/8"}, {Value: "192.168.0.0/16"}}
+	allowListName := "office"
+	interimAllowListName := "office-update"
+	allowListDescription := "Office network"
+	allowListID := "test-allow-list-id"
+	interimAllowListID := "test-interim-allow-list-id"
+	updatedAllowListID := "test-updated-allow-list-id"
+	allowList := getMockAllowList(cfg, mockNetworkApi, mockProjectApi, mockAccountApi)
+	allowList.p.client.ClusterApi = mockClusterApi
+	allowList.p.client.TaskApi = mockTaskApi
+
+	getAllowListRequest := getGetAllowListRequest(ctx, cfg, accountID, projectID, allowListID, mockNetworkApi)
+	getAllowListResponse := getAttachedAllowListResponse(allowListID, projectID, cidrList, allowListDescription, allowListName, clusterIDs)
+	deleteAllowListRequest := getDeleteAllowListRequest(ctx, cfg, accountID, projectID, allowListID, mockNetworkApi)
+	createAllowListRequest := getCreateAllowListRequest(ctx, cfg, accountID, projectID, mockNetworkApi)
+	createInterimAllowListRequestFinal := createAllowListRequest.NetworkAllowListSpec(*openapiclient.NewNetworkAllowListSpec(interimAllowListName, allowListDescription, updatedCidrList))
+	createInterimAllowListResponse := getCreateAllowListResponse(interimAllowListID, projectID, updatedCidrList, allowListDescription, interimAllowListName)
+	createAllowListRequestFinal := createAllowListRequest.NetworkAllowListSpec(*openapiclient.NewNetworkAllowListSpec(allowListName, allowListDescription, updatedCidrList))
+	createAllowListResponse := getCreateAllowListResponse(updatedAllowListID, projectID, updatedCidrList, allowListDescription, allowListName)
+	updatedAllowListResponse := getAttachedAllowListResponse(updatedAllowListID, projectID, updatedCidrList, allowListDescription, allowListName, clusterIDs)
+	deleteInterimAllowListRequest := getDeleteAllowListRequest(ctx, cfg, accountID, projectID, interimAllowListID, mockNetworkApi)
+	getUpdatedAllowListRequest := getGetAllowListRequest(ctx, cfg, accountID, projectID, updatedAllowListID, mockNetworkApi)
+	listNetworkAllowListsRequest := getListAllowListRequest(ctx, cfg, accountID, projectID, mockNetworkApi)
+	listNetworkAllowListsResponseBefore := getListAllowListResponse(allowListID, projectID, cidrList, allowListDescription, allowListName)
+	listNetworkAllowListsResponse := getListAllowListResponse(updatedAllowListID, projectID, updatedCidrList, allowListDescription, allowListName)
+
+	allowListType := resourceAllowListType{}
+	schema, _ := allowListType.GetSchema(ctx)
+	req := tfsdk.UpdateResourceRequest{}
+	req.State.Schema = schema
+	req.State.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             cidrListSchema,
+		AllowListID:          types.String{Value: allowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           clusterIDsSchema,
+	})
+	req.Plan.Schema = schema
+	req.Plan.Set(ctx, &AllowList{
+		AccountID:            types.String{Unknown: true},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             updatedCidrListSchema,
+		AllowListID:          types.String{Unknown: true},
+		ProjectID:            types.String{Unknown: true},
+		ClusterIDs:           nil,
+	})
+
+	updatedState := tfsdk.State{}
+	updatedState.Schema = schema
+	updatedState.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             updatedCidrListSchema,
+		AllowListID:          types.String{Value: updatedAllowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           clusterIDsSchema,
+	})
+	oldState := tfsdk.State{}
+	oldState.Schema = schema
+	oldState.Set(ctx, &AllowList{
+		AccountID:            types.String{Value: accountID},
+		AllowListName:        types.String{Value: allowListName},
+		AllowListDescription: types.String{Value: allowListDescription},
+		CIDRList:             cidrListSchema,
+		AllowListID:          types.String{Value: allowListID},
+		ProjectID:            types.String{Value: projectID},
+		ClusterIDs:           clusterIDsSchema,
+	})
+
+	httpSuccessResponse := &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+	}
+	apiError := errors.New("500 Internal Server Error")
+
+	// Every edit of the cluster adds an allow list before the one it replaces is removed, so the cluster always
+	// has either the old rules or the new ones.
+	testCases := []struct {
+		TestName        string
+		SetExpectations func() []*gomock.Call
+		ExpectedState   tfsdk.State
+		ExpectedErrors  bool
+	}{
+		{
+			TestName: "Allow list attached to a cluster",
+			SetExpectations: func() []*gomock.Call {
+				calls := expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{allowListID}, []string{allowListID, interimAllowListID}, nil, mockClusterApi, mockTaskApi)
+				calls = append(calls, expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{allowListID, interimAllowListID}, []string{interimAllowListID}, nil, mockClusterApi, mockTaskApi)...)
+				calls = append(calls,
+					mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*deleteAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteAllowListRequest).Return(httpSuccessResponse, nil).Times(1),
+					mockNetworkApi.EXPECT().CreateNetworkAllowList(ctx, accountID, projectID).Return(*createAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().CreateNetworkAllowListExecute(createAllowListRequestFinal).Return(*createAllowListResponse, httpSuccessResponse, nil).Times(1))
+				calls = append(calls, expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{interimAllowListID}, []string{interimAllowListID, updatedAllowListID}, nil, mockClusterApi, mockTaskApi)...)
+				calls = append(calls, expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{interimAllowListID, updatedAllowListID}, []string{updatedAllowListID}, nil, mockClusterApi, mockTaskApi)...)
+				return append(calls,
+					mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, interimAllowListID).Return(*deleteInterimAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteInterimAllowListRequest).Return(httpSuccessResponse, nil).Times(1),
+					mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1),
+					mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponse, httpSuccessResponse, nil).Times(1),
+					mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, updatedAllowListID).Return(*getUpdatedAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getUpdatedAllowListRequest).Return(*updatedAllowListResponse, httpSuccessResponse, nil).Times(1))
+			},
+			ExpectedState: updatedState,
+		},
+		{
+			TestName: "Interim allow list cannot be attached",
+			SetExpectations: func() []*gomock.Call {
+				// The interim allow list is detached and deleted again, the cluster keeps the old allow list
+				calls := expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{allowListID}, []string{allowListID, interimAllowListID}, apiError, mockClusterApi, mockTaskApi)
+				calls = append(calls, expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{allowListID}, []string{allowListID}, nil, mockClusterApi, mockTaskApi)...)
+				calls = append(calls, expectClusterAllowListEdit(t, ctx, cfg, accountID, projectID, clusterID, []string{allowListID}, []string{allowListID}, nil, mockClusterApi, mockTaskApi)...)
+				return append(calls,
+					mockNetworkApi.EXPECT().DeleteNetworkAllowList(ctx, accountID, projectID, interimAllowListID).Return(*deleteInterimAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().DeleteNetworkAllowListExecute(*deleteInterimAllowListRequest).Return(httpSuccessResponse, nil).Times(1),
+					mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1),
+					mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponseBefore, httpSuccessResponse, nil).Times(1),
+					mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*getAllowListRequest).Times(1),
+					mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getAllowListRequest).Return(*getAllowListResponse, httpSuccessResponse, nil).Times(1))
+			},
+			ExpectedState:  oldState,
+			ExpectedErrors: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			resp := &tfsdk.UpdateResourceResponse{}
+			resp.State.Schema = schema
+
+			// The interim allow list is always created first
+			calls := []*gomock.Call{
+				mockNetworkApi.EXPECT().GetNetworkAllowList(ctx, accountID, projectID, allowListID).Return(*getAllowListRequest).Times(1),
+				mockNetworkApi.EXPECT().GetNetworkAllowListExecute(*getAllowListRequest).Return(*getAllowListResponse, httpSuccessResponse, nil).Times(1),
+				mockNetworkApi.EXPECT().ListNetworkAllowLists(ctx, accountID, projectID).Return(*listNetworkAllowListsRequest).Times(1),
+				mockNetworkApi.EXPECT().ListNetworkAllowListsExecute(*listNetworkAllowListsRequest).Return(*listNetworkAllowListsResponseBefore, httpSuccessResponse, nil).Times(1),
+				mockNetworkApi.EXPECT().CreateNetworkAllowList(ctx, accountID, projectID).Return(*createAllowListRequest).Times(1),
+				mockNetworkApi.EXPECT().CreateNetworkAllowListExecute(createInterimAllowListRequestFinal).Return(*createInterimAllowListResponse, httpSuccessResponse, nil).Times(1),
+			}
+			gomock.InOrder(append(calls, testCase.SetExpectations()...)...)
+			allowList.Update(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.ExpectedErrors {
+				t.Errorf("Got errors: %v, Expected errors: %v", resp.Diagnostics, testCase.ExpectedErrors)
+			}
+			if resp.Diagnostics.WarningsCount() > 0 {
+				t.Errorf("Got warnings: %v, Expected no warnings", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(resp.State, testCase.ExpectedState) {
+				t.Errorf("Got State: %v, Expected State: %v", resp.State, testCase.ExpectedState)
 			}
 		})
 
@@ -370,3 +757,40 @@ func TestImportStateAllowList(t *testing.T) {
 	allowList := getMockAllowList(cfg, mockNetworkApi, mockProjectApi, mockAccountApi)
 	allowList.ImportState(ctx, req, resp)
 }
+
+func TestKeepConfiguredCIDRNotation(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		ReadCIDRs       []types.String
+		ConfiguredCIDRs []types.String
+		ExpectedCIDRs   []types.String
+	}{
+		{
+			TestName:        "Same notation",
+			ReadCIDRs:       []types.String{{Value: "10.0.0.0/16"}},
+			ConfiguredCIDRs: []types.String{{Value: "10.0.0.0/16"}},
+			ExpectedCIDRs:   []types.String{{Value: "10.0.0.0/16"}},
+		},
+		{
+			TestName:        "Single address",
+			ReadCIDRs:       []types.String{{Value: "203.0.113.10/32"}, {Value: "10.0.0.0/16"}},
+			ConfiguredCIDRs: []types.String{{Value: "10.0.0.0/16"}, {Value: "203.0.113.10"}},
+			ExpectedCIDRs:   []types.String{{Value: "203.0.113.10"}, {Value: "10.0.0.0/16"}},
+		},
+		{
+			TestName:        "CIDR changed outside of terraform",
+			ReadCIDRs:       []types.String{{Value: "10.1.0.0/16"}},
+			ConfiguredCIDRs: []types.String{{Value: "10.0.0.0/16"}},
+			ExpectedCIDRs:   []types.String{{Value: "10.1.0.0/16"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotCIDRs := keepConfiguredCIDRNotation(testCase.ReadCIDRs, testCase.ConfiguredCIDRs)
+			if !reflect.DeepEqual(gotCIDRs, testCase.ExpectedCIDRs) {
+				t.Errorf("Got CIDRs: %v, Expected CIDRs: %v", gotCIDRs, testCase.ExpectedCIDRs)
+			}
+		})
+	}
+}
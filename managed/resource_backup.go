@@ -7,14 +7,14 @@ package managed
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	//"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	retry "github.com/sethvargo/go-retry"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
@@ -30,30 +30,42 @@ func (r resourceBackupType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Description: "The ID of the account this backup belongs to.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"cluster_id": {
 				Description: "The ID of the cluster to be backed up.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
 			},
 			"project_id": {
 				Description: "The ID of the project this backup belongs to.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"backup_id": {
 				Description: "The ID of the backup. Created automatically when the backup is created. Used to get a specific backup.",
 				Type:        types.StringType,
 				Computed:    true,
 				Optional:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"backup_description": {
-				Description: "The description of the backup.",
+				Description: "The description of the backup. Can be changed without taking a new backup.",
 				Type:        types.StringType,
 				Required:    true,
 			},
 			"retention_period_in_days": {
-				Description: "The retention period of the backup.",
+				Description: "The retention period of the backup. Can be changed without taking a new backup.",
 				Type:        types.Int64Type,
 				Required:    true,
 			},
@@ -72,6 +84,15 @@ func (r resourceBackupType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Type:        types.BoolType,
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"retain_on_destroy": {
+				Description: "Set to true to keep the backup when the resource is destroyed. The backup is then only removed from the Terraform state and is deleted at the end of its retention period.",
+				Type:        types.BoolType,
+				Optional:    true,
 			},
 		},
 	}, nil
@@ -87,7 +108,7 @@ type resourceBackup struct {
 	p provider
 }
 
-func getBackupPlan(ctx context.Context, plan tfsdk.Plan, backup *Backup) diag.Diagnostics {
+func getBackupPlan(ctx context.Context, plan tfsdk.Plan, backup *BackupResource) diag.Diagnostics {
 	// NOTE: currently must manually fill out each attribute due to usage of Go structs
 	// Once the opt-in conversion of null or unknown values to the empty value is implemented, this can all be replaced with req.Plan.Get(ctx, &backup)
 	// See https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
@@ -99,6 +120,7 @@ func getBackupPlan(ctx context.Context, plan tfsdk.Plan, backup *Backup) diag.Di
 	diags.Append(plan.GetAttribute(ctx, path.Root("backup_description"), &backup.BackupDescription)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("retention_period_in_days"), &backup.RetentionPeriodInDays)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("use_roles"), &backup.UseRoles)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("retain_on_destroy"), &backup.RetainOnDestroy)...)
 
 	return diags
 }
@@ -114,7 +136,7 @@ func (r resourceBackup) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
-	var plan Backup
+	var plan BackupResource
 	var accountId, message string
 	var getAccountOK bool
 	diags := req.Plan.Get(ctx, &plan)
@@ -188,6 +210,7 @@ func (r resourceBackup) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		resp.Diagnostics.AddError("Unable to read the state of the backup ", message)
 		return
 	}
+	backup.RetainOnDestroy = plan.RetainOnDestroy
 
 	diags = resp.State.Set(ctx, &backup)
 	resp.Diagnostics.Append(diags...)
@@ -196,13 +219,14 @@ func (r resourceBackup) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 	}
 }
 
-func getIDsFromBackupState(ctx context.Context, state tfsdk.State, backup *Backup) {
+func getIDsFromBackupState(ctx context.Context, state tfsdk.State, backup *BackupResource) {
 	state.GetAttribute(ctx, path.Root("account_id"), &backup.AccountID)
 	state.GetAttribute(ctx, path.Root("project_id"), &backup.ProjectID)
 	state.GetAttribute(ctx, path.Root("backup_id"), &backup.BackupID)
+	state.GetAttribute(ctx, path.Root("retain_on_destroy"), &backup.RetainOnDestroy)
 }
 
-func resourceBackupRead(accountId string, projectId string, backupId string, apiClient *openapiclient.APIClient) (backup Backup, readOK bool, errorMessage string) {
+func resourceBackupRead(accountId string, projectId string, backupId string, apiClient *openapiclient.APIClient) (backup BackupResource, readOK bool, errorMessage string) {
 	backupResp, response, err := apiClient.BackupApi.GetBackup(context.Background(), accountId, projectId, backupId).Execute()
 	if err != nil {
 		errMsg := getErrorMessage(response, err)
@@ -231,7 +255,7 @@ func resourceBackupRead(accountId string, projectId string, backupId string, api
 
 // Read backup
 func (r resourceBackup) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
-	var state Backup
+	var state BackupResource
 	getIDsFromBackupState(ctx, req.State, &state)
 
 	backup, readOK, message := resourceBackupRead(state.AccountID.Value, state.ProjectID.Value, state.BackupID.Value, r.p.client)
@@ -239,6 +263,7 @@ func (r resourceBackup) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 		resp.Diagnostics.AddError("Unable to read the state of the backup ", message)
 		return
 	}
+	backup.RetainOnDestroy = state.RetainOnDestroy
 
 	diags := resp.State.Set(ctx, &backup)
 	resp.Diagnostics.Append(diags...)
@@ -247,21 +272,62 @@ func (r resourceBackup) Read(ctx context.Context, req tfsdk.ReadResourceRequest,
 	}
 }
 
-// Update backup
+// Update backup. Only the description and the retention period of a backup can be changed, the
+// other attributes either require a new backup or only live in the Terraform state.
 func (r resourceBackup) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state BackupResource
+	resp.Diagnostics.Append(getBackupPlan(ctx, req.Plan, &plan)...)
+	getIDsFromBackupState(ctx, req.State, &state)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("backup_description"), &state.BackupDescription)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("retention_period_in_days"), &state.RetentionPeriodInDays)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := state.AccountID.Value
+	projectId := state.ProjectID.Value
+	backupId := state.BackupID.Value
+	apiClient := r.p.client
+
+	if plan.BackupDescription.Value != state.BackupDescription.Value || plan.RetentionPeriodInDays.Value != state.RetentionPeriodInDays.Value {
+		backupRetentionPeriodInDays := int32(plan.RetentionPeriodInDays.Value)
+		editBackupSpec := *openapiclient.NewEditBackupSpecWithDefaults()
+		editBackupSpec.SetDescription(plan.BackupDescription.Value)
+		editBackupSpec.SetRetentionPeriodInDays(backupRetentionPeriodInDays)
 
-	resp.Diagnostics.AddError("Unable to update backup.", "Updating backups is not currently supported. Delete and recreate the provider.")
-	return
+		_, response, err := apiClient.BackupApi.EditBackup(ctx, accountId, projectId, backupId).EditBackupSpec(editBackupSpec).Execute()
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			resp.Diagnostics.AddError("Unable to update the backup", errMsg)
+			return
+		}
+	}
+
+	backup, readOK, message := resourceBackupRead(accountId, projectId, backupId, apiClient)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the state of the backup ", message)
+		return
+	}
+	backup.RetainOnDestroy = plan.RetainOnDestroy
+
+	diags := resp.State.Set(ctx, &backup)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete backup
 func (r resourceBackup) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
-	var state Backup
+	var state BackupResource
 	getIDsFromBackupState(ctx, req.State, &state)
 	accountId := state.AccountID.Value
 	projectId := state.ProjectID.Value
 	backupId := state.BackupID.Value
 
+	if state.RetainOnDestroy.Value {
+		tflog.Info(ctx, fmt.Sprintf("Keeping backup %v as retain_on_destroy is set, removing it from the state only", backupId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	apiClient := r.p.client
 
 	response, err := apiClient.BackupApi.DeleteBackup(context.Background(), accountId, projectId, backupId).Execute()
@@ -11,12 +11,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	retry "github.com/sethvargo/go-retry"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -24,7 +26,7 @@ type resourceBackupRestoreType struct{}
 
 func (r resourceBackupRestoreType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		Description: `Restore a backup to a cluster. All attributes are immutable; changing any attribute triggers destroy and create (a new restore). Use ysql_databases and ycql_keyspaces for selective restore; omit to restore all databases/keyspaces.`,
+		Description: `Restore a backup to a cluster. All attributes are immutable; changing any attribute triggers destroy and create (a new restore). Use ysql_databases and ycql_keyspaces for selective restore; omit to restore all databases/keyspaces. When the backup and the target cluster already exist, the selected databases and keyspaces, the renames and the database versions are checked during plan.`,
 		Attributes: map[string]tfsdk.Attribute{
 			"account_id": {
 				Description: "The ID of the account.",
@@ -67,7 +69,7 @@ func (r resourceBackupRestoreType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				},
 			},
 			"ysql_databases": {
-				Description: "List of YSQL databases to restore. If empty or omitted, all YSQL databases are restored.",
+				Description: "List of YSQL databases to restore. If empty or omitted, all YSQL databases are restored. Only the presence of the name in the backup is checked at plan time, the backup does not tell YSQL databases and YCQL keyspaces apart.",
 				Type: types.ListType{
 					ElemType: types.StringType,
 				},
@@ -78,7 +80,7 @@ func (r resourceBackupRestoreType) GetSchema(_ context.Context) (tfsdk.Schema, d
 				},
 			},
 			"ycql_keyspaces": {
-				Description: "List of YCQL keyspaces to restore. If empty or omitted, all YCQL keyspaces are restored.",
+				Description: "List of YCQL keyspaces to restore. If empty or omitted, all YCQL keyspaces are restored. Only the presence of the name in the backup is checked at plan time, the backup does not tell YSQL databases and YCQL keyspaces apart.",
 				Type: types.ListType{
 					ElemType: types.StringType,
 				},
@@ -211,6 +213,103 @@ func buildRestoreSpec(plan *BackupRestore) *openapiclient.RestoreSpec {
 	return restoreSpec
 }
 
+var _ tfsdk.ResourceWithModifyPlan = resourceBackupRestore{}
+
+// ModifyPlan checks a new restore against the contents of the backup and the target cluster, so
+// that a typo in a database name or a rename onto an existing database fails the plan instead of
+// the restore task.
+func (r resourceBackupRestore) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Only a new restore sends anything to the API
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || !r.p.configured {
+		return
+	}
+
+	// The backup and the cluster may not exist yet, e.g. when they are created in the same apply
+	for _, attribute := range []string{"backup_id", "target_cluster_id", "ysql_databases", "ycql_keyspaces", "ysql_databases_rename", "ycql_keyspaces_rename"} {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rawValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !rawValue.IsFullyKnown() {
+			return
+		}
+	}
+
+	var plan BackupRestore
+	resp.Diagnostics.Append(getBackupRestorePlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	backupResp, response, err := apiClient.BackupApi.GetBackup(ctx, accountId, projectId, plan.BackupID.Value).Execute()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("backup_id"), "Unable to read the backup", getErrorMessage(response, err))
+		return
+	}
+	clusterId := plan.TargetClusterID.Value
+	clusterResp, response, err := apiClient.ClusterApi.GetCluster(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("target_cluster_id"), "Unable to read the target cluster", getErrorMessage(response, err))
+		return
+	}
+	namespacesResp, response, err := apiClient.ClusterApi.GetClusterNamespaces(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("target_cluster_id"), "Unable to get the namespaces of the target cluster", getErrorMessage(response, err))
+		return
+	}
+
+	backupVersion := backupResp.Data.Info.GetSoftwareVersion()
+	clusterVersion := clusterResp.Data.Info.GetSoftwareVersion()
+	if compatible, message := util.IsRestoreVersionCompatible(backupVersion, clusterVersion); !compatible {
+		resp.Diagnostics.AddAttributeError(path.Root("target_cluster_id"), "Incompatible database version", message)
+	}
+
+	var targetYSQLDatabases, targetYCQLKeyspaces []string
+	for _, namespace := range namespacesResp.Data {
+		switch namespace.GetTableType() {
+		case GetNamespaceTypeMap()["YSQL"]:
+			targetYSQLDatabases = append(targetYSQLDatabases, namespace.GetName())
+		case GetNamespaceTypeMap()["YCQL"]:
+			targetYCQLKeyspaces = append(targetYCQLKeyspaces, namespace.GetName())
+		}
+	}
+
+	selection := util.RestoreSelection{
+		YSQLDatabases: util.SliceTypesStringToSliceString(plan.YSQLDatabases),
+		YCQLKeyspaces: util.SliceTypesStringToSliceString(plan.YCQLKeyspaces),
+		YSQLRenames:   restoreRenames(plan.YSQLDatabasesRename),
+		YCQLRenames:   restoreRenames(plan.YCQLKeyspacesRename),
+	}
+	for _, selectionError := range util.ValidateRestoreSelection(selection, backupResp.Data.Info.GetDatabases(), targetYSQLDatabases, targetYCQLKeyspaces) {
+		resp.Diagnostics.AddAttributeError(path.Root(selectionError.Attribute).AtListIndex(selectionError.Index), selectionError.Summary, selectionError.Detail)
+	}
+}
+
+func restoreRenames(renameBlocks []DatabaseRenameBlock) []util.RestoreRename {
+	var renames []util.RestoreRename
+	for _, renameBlock := range renameBlocks {
+		renames = append(renames, util.RestoreRename{
+			BackupDatabase:  renameBlock.BackupDatabase.Value,
+			RestoreDatabase: renameBlock.RestoreDatabase.Value,
+		})
+	}
+	return renames
+}
+
 func (r resourceBackupRestore) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
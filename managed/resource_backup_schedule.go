@@ -0,0 +1,345 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type resourceBackupScheduleType struct{}
+
+func (r resourceBackupScheduleType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The resource to manage a backup schedule of a cluster in YugabyteDB Aeon. A cluster can have several
+schedules, for example a daily full backup and an hourly incremental one. Do not manage the schedule set in the
+backup_schedules attribute of the ybm_cluster resource with this resource as well.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this backup schedule belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"project_id": {
+				Description: "The ID of the project this backup schedule belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster to back up.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"schedule_id": {
+				Description: "The ID of the backup schedule.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"state": {
+				Description: "The state of the backup schedule. Used to pause or resume the backup schedule. Valid values are ACTIVE or PAUSED. Defaults to ACTIVE.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("ACTIVE", "PAUSED")},
+			},
+			"cron_expression": {
+				Description: "The cron expression for the backup schedule.",
+				Type:        types.StringType,
+				Optional:    true,
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ExactlyOneOf(path.MatchRoot("time_interval_in_days")),
+				},
+			},
+			"time_interval_in_days": {
+				Description: "The time interval in days for the backup schedule.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
+			},
+			"retention_period_in_days": {
+				Description: "The retention period of the backups taken by the schedule.",
+				Type:        types.Int64Type,
+				Required:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
+			},
+			"incremental_interval_in_mins": {
+				Description: "The time interval in minutes for the incremental backups taken between the full backups of the schedule.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(60)},
+			},
+			"backup_description": {
+				Description: "The description of the backup schedule.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"use_roles": {
+				Description: "Backup global YSQL roles in the scheduled backups. Defaults to false.",
+				Type:        types.BoolType,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r resourceBackupScheduleType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceBackupSchedule{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceBackupSchedule struct {
+	p provider
+}
+
+func getBackupSchedulePlan(ctx context.Context, plan tfsdk.Plan, backupSchedule *BackupSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(plan.GetAttribute(ctx, path.Root("cluster_id"), &backupSchedule.ClusterID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("state"), &backupSchedule.State)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("cron_expression"), &backupSchedule.CronExpression)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("time_interval_in_days"), &backupSchedule.TimeIntervalInDays)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("retention_period_in_days"), &backupSchedule.RetentionPeriodInDays)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("incremental_interval_in_mins"), &backupSchedule.IncrementalIntervalInMins)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("backup_description"), &backupSchedule.BackupDescription)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("use_roles"), &backupSchedule.UseRoles)...)
+	return diags
+}
+
+func (backupSchedule BackupSchedule) info() BackupScheduleInfo {
+	state := backupSchedule.State
+	if state.IsNull() || state.IsUnknown() {
+		state = types.String{Value: "ACTIVE"}
+	}
+	return BackupScheduleInfo{
+		State:                     state,
+		RetentionPeriodInDays:     backupSchedule.RetentionPeriodInDays,
+		CronExpression:            backupSchedule.CronExpression,
+		TimeIntervalInDays:        backupSchedule.TimeIntervalInDays,
+		IncrementalIntervalInMins: backupSchedule.IncrementalIntervalInMins,
+		UseRoles:                  backupSchedule.UseRoles,
+	}
+}
+
+// backupScheduleDescription is the description to send for the schedule. The prior description
+// is kept when the configuration does not set one.
+func backupScheduleDescription(plan BackupSchedule, state BackupSchedule) string {
+	if !plan.BackupDescription.IsNull() && !plan.BackupDescription.IsUnknown() {
+		return plan.BackupDescription.Value
+	}
+	return state.BackupDescription.Value
+}
+
+// resourceBackupScheduleRead reads the backup schedule with the given ID. found is false if the
+// schedule or its cluster do not exist anymore.
+func resourceBackupScheduleRead(ctx context.Context, accountId string, projectId string, clusterId string, scheduleId string, apiClient *openapiclient.APIClient) (backupSchedule BackupSchedule, found bool, readOK bool, errorMessage string) {
+	backupScheduleInfo, response, err := readBackupScheduleInfoV2(ctx, apiClient, accountId, projectId, clusterId, scheduleId)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return backupSchedule, false, true, ""
+		}
+		return backupSchedule, false, false, getErrorMessage(response, err)
+	}
+	info := backupScheduleInfo[0]
+
+	backupSchedule = BackupSchedule{
+		AccountID:                 types.String{Value: accountId},
+		ProjectID:                 types.String{Value: projectId},
+		ClusterID:                 types.String{Value: clusterId},
+		ScheduleID:                types.String{Value: scheduleId},
+		State:                     info.State,
+		CronExpression:            info.CronExpression,
+		TimeIntervalInDays:        info.TimeIntervalInDays,
+		RetentionPeriodInDays:     info.RetentionPeriodInDays,
+		IncrementalIntervalInMins: info.IncrementalIntervalInMins,
+		BackupDescription:         info.BackupDescription,
+		UseRoles:                  info.UseRoles,
+	}
+	// Only one of the cron expression and the time interval is set on a schedule
+	if backupSchedule.CronExpression.Value == "" {
+		backupSchedule.CronExpression = types.String{Null: true}
+	}
+	if backupSchedule.TimeIntervalInDays.Value == 0 {
+		backupSchedule.TimeIntervalInDays = types.Int64{Null: true}
+	}
+	return backupSchedule, true, true, ""
+}
+
+func (r resourceBackupSchedule) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var plan BackupSchedule
+	resp.Diagnostics.Append(getBackupSchedulePlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	clusterId := plan.ClusterID.Value
+	backupScheduleSpec, err := createBackupScheduleSpecV2(plan.info(), backupScheduleDescription(plan, BackupSchedule{}))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create the backup schedule", err.Error())
+		return
+	}
+	scheduleResp, response, err := apiClient.BackupApi.CreateBackupScheduleV2(ctx, accountId, projectId, clusterId).ScheduleSpecV2(backupScheduleSpec).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError("Unable to create the backup schedule", errMsg)
+		return
+	}
+	scheduleId := scheduleResp.Data.Info.GetId()
+	tflog.Info(ctx, fmt.Sprintf("Created backup schedule %v for cluster %v", scheduleId, clusterId))
+
+	backupSchedule, found, readOK, message := resourceBackupScheduleRead(ctx, accountId, projectId, clusterId, scheduleId, apiClient)
+	if !readOK || !found {
+		resp.Diagnostics.AddError("Unable to read the state of the backup schedule", message)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &backupSchedule)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBackupSchedule) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state BackupSchedule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	backupSchedule, found, readOK, message := resourceBackupScheduleRead(ctx, accountId, projectId, state.ClusterID.Value, state.ScheduleID.Value, apiClient)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the state of the backup schedule", message)
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("Backup schedule %v of cluster %v not found, removing it from the state", state.ScheduleID.Value, state.ClusterID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &backupSchedule)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBackupSchedule) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state BackupSchedule
+	resp.Diagnostics.Append(getBackupSchedulePlan(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId := state.AccountID.Value
+	projectId := state.ProjectID.Value
+	clusterId := state.ClusterID.Value
+	scheduleId := state.ScheduleID.Value
+
+	err := editBackupScheduleV2(ctx, plan.info(), scheduleId, backupScheduleDescription(plan, state), accountId, projectId, clusterId, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update the backup schedule", err.Error())
+		return
+	}
+
+	backupSchedule, found, readOK, message := resourceBackupScheduleRead(ctx, accountId, projectId, clusterId, scheduleId, apiClient)
+	if !readOK || !found {
+		resp.Diagnostics.AddError("Unable to read the state of the backup schedule", message)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &backupSchedule)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceBackupSchedule) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state BackupSchedule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	response, err := apiClient.BackupApi.DeleteBackupScheduleV2(ctx, state.AccountID.Value, state.ProjectID.Value, state.ClusterID.Value, state.ScheduleID.Value).Execute()
+	if err != nil && (response == nil || response.StatusCode != http.StatusNotFound) {
+		errMsg := getErrorMessage(response, err)
+		resp.Diagnostics.AddError("Unable to delete the backup schedule", errMsg)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import backup schedule
+func (r resourceBackupSchedule) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: schedule_id,cluster_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("schedule_id"), idParts[0])
+	resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[1])
+}
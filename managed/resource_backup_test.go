@@ -0,0 +1,131 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mocks "github.com/yugabyte/terraform-provider-ybm/mock_yugabytedb_managed_go_client_internal"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+func getDeleteBackupRequest(ctx context.Context, cfg *openapiclient.Configuration, accountID string, projectID string, backupID string, mockBackupApi *mocks.MockBackupApi) *openapiclient.ApiDeleteBackupRequest {
+	testClient := openapiclient.NewAPIClient(cfg)
+	deleteBackupRequest := testClient.BackupApi.DeleteBackup(ctx, accountID, projectID, backupID)
+	deleteBackupRequest.ApiService = mockBackupApi
+	return &deleteBackupRequest
+}
+
+func getGetBackupRequest(ctx context.Context, cfg *openapiclient.Configuration, accountID string, projectID string, backupID string, mockBackupApi *mocks.MockBackupApi) *openapiclient.ApiGetBackupRequest {
+	testClient := openapiclient.NewAPIClient(cfg)
+	getBackupRequest := testClient.BackupApi.GetBackup(ctx, accountID, projectID, backupID)
+	getBackupRequest.ApiService = mockBackupApi
+	return &getBackupRequest
+}
+
+func TestDeleteBackup(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockBackupApi := mocks.NewMockBackupApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	backupID := "test-backup-id"
+
+	apiClient := openapiclient.NewAPIClient(cfg)
+	apiClient.BackupApi = mockBackupApi
+	backup := resourceBackup{
+		p: provider{
+			configured: true,
+			client:     apiClient,
+		},
+	}
+	deleteBackupRequest := getDeleteBackupRequest(ctx, cfg, accountID, projectID, backupID, mockBackupApi)
+	getBackupRequest := getGetBackupRequest(ctx, cfg, accountID, projectID, backupID, mockBackupApi)
+	backupNotFoundResponse := &http.Response{StatusCode: http.StatusNotFound}
+
+	backupType := resourceBackupType{}
+	schema, _ := backupType.GetSchema(ctx)
+
+	testCases := []struct {
+		TestName          string
+		RetainOnDestroy   types.Bool
+		DeleteError       error
+		ExpectDeleteCalls int
+		ExpectGetCalls    int
+		ExpectedErrors    bool
+	}{
+		{
+			TestName:          "Backup retained on destroy",
+			RetainOnDestroy:   types.Bool{Value: true},
+			ExpectDeleteCalls: 0,
+			ExpectGetCalls:    0,
+		},
+		{
+			TestName:          "Backup deleted when retain_on_destroy is not set",
+			RetainOnDestroy:   types.Bool{Null: true},
+			ExpectDeleteCalls: 1,
+			ExpectGetCalls:    1,
+		},
+		{
+			TestName:          "Backup deleted when retain_on_destroy is false",
+			RetainOnDestroy:   types.Bool{Value: false},
+			ExpectDeleteCalls: 1,
+			ExpectGetCalls:    1,
+		},
+		{
+			TestName:          "Backup deletion fails",
+			RetainOnDestroy:   types.Bool{Value: false},
+			DeleteError:       errors.New("internal server error"),
+			ExpectDeleteCalls: 1,
+			ExpectGetCalls:    0,
+			ExpectedErrors:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			req := tfsdk.DeleteResourceRequest{}
+			req.State.Schema = schema
+			req.State.Set(ctx, &BackupResource{
+				AccountID:             types.String{Value: accountID},
+				ProjectID:             types.String{Value: projectID},
+				ClusterID:             types.String{Value: "test-cluster-id"},
+				BackupID:              types.String{Value: backupID},
+				BackupDescription:     types.String{Value: "Backup before the upgrade"},
+				RetentionPeriodInDays: types.Int64{Value: 7},
+				MostRecent:            types.Bool{Null: true},
+				Timestamp:             types.String{Null: true},
+				UseRoles:              types.Bool{Value: false},
+				RetainOnDestroy:       testCase.RetainOnDestroy,
+			})
+			resp := &tfsdk.DeleteResourceResponse{}
+			resp.State = req.State
+
+			// Once deleted, the backup is looked up until it is not found anymore.
+			mockBackupApi.EXPECT().DeleteBackup(ctx, accountID, projectID, backupID).Return(*deleteBackupRequest).Times(testCase.ExpectDeleteCalls)
+			mockBackupApi.EXPECT().DeleteBackupExecute(*deleteBackupRequest).Return(nil, testCase.DeleteError).Times(testCase.ExpectDeleteCalls)
+			mockBackupApi.EXPECT().GetBackup(ctx, accountID, projectID, backupID).Return(*getBackupRequest).Times(testCase.ExpectGetCalls)
+			mockBackupApi.EXPECT().GetBackupExecute(*getBackupRequest).Return(openapiclient.BackupResponse{}, backupNotFoundResponse, errors.New("not found")).Times(testCase.ExpectGetCalls)
+			backup.Delete(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.ExpectedErrors {
+				t.Errorf("Got errors: %v, Expected errors: %v", resp.Diagnostics, testCase.ExpectedErrors)
+			}
+			if !testCase.ExpectedErrors && !resp.State.Raw.IsNull() {
+				t.Errorf("Got State: %v, Expected the backup to be removed from the state", resp.State)
+			}
+		})
+	}
+}
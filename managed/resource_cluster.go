@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -27,9 +28,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/sethvargo/go-retry"
 	"github.com/yugabyte/terraform-provider-ybm/managed/fflags"
+	planmodifier "github.com/yugabyte/terraform-provider-ybm/managed/plan_modifier"
 	"github.com/yugabyte/terraform-provider-ybm/managed/util"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
@@ -95,15 +98,21 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			Validators: []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "GCP", "AZURE")},
 		},
 		"cluster_region_info": {
-			Required: true,
-			Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+			Description: "The regions of the cluster, keyed by region code.",
+			Required:    true,
+			Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
 				"num_nodes": {
 					Type:     types.Int64Type,
 					Required: true,
 				},
 				"region": {
-					Type:     types.StringType,
-					Required: true,
+					Description: "The region code. Always the same as the key of the region in cluster_region_info.",
+					Type:        types.StringType,
+					Optional:    true,
+					Computed:    true,
+					PlanModifiers: []tfsdk.AttributePlanModifier{
+						planmodifier.MapKeyModifier{},
+					},
 				},
 				"num_zones": {
 					Description: "Number of zones in the region.",
@@ -134,6 +143,11 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						),
 					},
 				},
+				"current_disk_size_gb": {
+					Description: "The disk size of the nodes of the region as reported by the server. Differs from disk_size_gb when storage autoscaling has grown the disk.",
+					Type:        types.Int64Type,
+					Computed:    true,
+				},
 				"disk_iops": {
 					Description: "Disk IOPS of the nodes of the region.",
 					Type:        types.Int64Type,
@@ -197,7 +211,7 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					Computed:    true,
 				},
 				"backup_region": {
-					Description: "Indicates whether cluster backup data will be stored in this region.",
+					Description: "Indicates whether cluster backup data will be stored in this region. Use the ybm_cluster_backup_region resource to change the backup regions.",
 					Type:        types.BoolType,
 					Computed:    true,
 				},
@@ -498,7 +512,8 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			}),
 		},
 		"backup_schedules": {
-			Optional: true,
+			Description: "The default backup schedule of the cluster, or the schedule given by schedule_id. Use the ybm_backup_schedule resource to manage additional schedules, the cluster resource never edits them.",
+			Optional:    true,
 			Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
 				"state": {
 					Description: "The state of the backup schedule. Used to pause or resume the backup schedule. Valid values are ACTIVE or PAUSED.",
@@ -568,163 +583,43 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			}),
 		},
 		"cmk_spec": {
-			Description: "KMS Provider Configuration.",
-			Optional:    true,
+			Description:        "KMS Provider Configuration.",
+			Optional:           true,
+			DeprecationMessage: "Remove this attribute's configuration and manage the customer managed key of the cluster with the ybm_cluster_cmk resource instead. The attribute will be removed in the next major version of the provider.",
+			Attributes:         tfsdk.SingleNestedAttributes(cmkSpecSchemaAttributes()),
+		},
+		"storage_autoscaling": {
+			Description: "Storage autoscaling policy. When enabled, the disk of each node is grown automatically once its usage crosses the threshold. " +
+				"Disk growth within the policy is accepted on refresh instead of being planned back to the configured disk size.",
+			Optional: true,
 			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-				"provider_type": {
-					Description: "CMK Provider Type.",
-					Type:        types.StringType,
-					Required:    true,
-					Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "GCP", "AZURE")},
-				},
-				"is_enabled": {
-					Description: "Is Enabled",
+				"enabled": {
+					Description: "Whether storage autoscaling is enabled.",
 					Type:        types.BoolType,
 					Required:    true,
 				},
-				"aws_cmk_spec": {
-					Description: "AWS CMK Provider Configuration.",
-					Optional:    true,
-					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-						"access_key": {
-							Description: "Access Key",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"secret_key": {
-							Description: "Secret Key",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"arn_list": {
-							Description: "AWS ARN List",
-							Type:        types.ListType{ElemType: types.StringType},
-							Required:    true,
-						},
-					}),
+				"threshold_percentage": {
+					Description: "Disk usage percentage at which the disk is grown. Must be between 50 and 95.",
+					Type:        types.Int64Type,
+					Required:    true,
+					Validators:  []tfsdk.AttributeValidator{int64validator.Between(50, 95)},
 				},
-				"gcp_cmk_spec": {
-					Description: "GCP CMK Provider Configuration.",
-					Optional:    true,
-					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-						"key_ring_name": {
-							Description: "Key Ring Name",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"key_name": {
-							Description: "Key Name",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"location": {
-							Description: "Location",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"protection_level": {
-							Description: "Key Protection Level",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"gcp_service_account": {
-							Description: "GCP Service Account",
-							Required:    true,
-							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-								"type": {
-									Description: "Service Account Type",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"project_id": {
-									Description: "GCP Project ID",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"private_key": {
-									Description: "Private Key",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"private_key_id": {
-									Description: "Private Key ID",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"client_email": {
-									Description: "Client Email",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"client_id": {
-									Description: "Client ID",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"auth_uri": {
-									Description: "Auth URI",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"token_uri": {
-									Description: "Token URI",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"auth_provider_x509_cert_url": {
-									Description: "Auth Provider X509 Cert URL",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"client_x509_cert_url": {
-									Description: "Client X509 Cert URL",
-									Type:        types.StringType,
-									Required:    true,
-								},
-								"universe_domain": {
-									Description: "Google Universe Domain",
-									Type:        types.StringType,
-									Optional:    true,
-								},
-							}),
-						},
-					}),
+				"increment_gb": {
+					Description: "Size in GB by which the disk is grown each time the threshold is crossed.",
+					Type:        types.Int64Type,
+					Required:    true,
+					Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
 				},
-				"azure_cmk_spec": {
-					Description: "AZURE CMK Provider Configuration.",
-					Optional:    true,
-					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
-						"client_id": {
-							Description: "Azure Active Directory (AD) Client ID for Key Vault service principal.",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"client_secret": {
-							Description: "Azure AD Client Secret for Key Vault service principal.",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"tenant_id": {
-							Description: "Azure AD Tenant ID for Key Vault service principal.",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"key_vault_uri": {
-							Description: "URI of Azure Key Vault storing cryptographic keys.",
-							Type:        types.StringType,
-							Required:    true,
-						},
-						"key_name": {
-							Description: "Name of cryptographic key in Azure Key Vault.",
-							Type:        types.StringType,
-							Required:    true,
-						},
-					}),
+				"max_disk_size_gb": {
+					Description: "Maximum size in GB the disk may be grown to.",
+					Type:        types.Int64Type,
+					Required:    true,
+					Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
 				},
 			}),
 		},
 		"cluster_tier": {
-			Description: "FREE (Sandbox) or PAID (Dedicated).",
+			Description: "FREE (Sandbox) or PAID (Dedicated). A FREE cluster can be upgraded to PAID in place; a PAID cluster cannot be downgraded to FREE.",
 			Type:        types.StringType,
 			Required:    true,
 			Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("FREE", "PAID")},
@@ -759,7 +654,7 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			},
 		},
 		"cluster_allow_list_ids": {
-			Description: "List of IDs of the allow lists assigned to the cluster.",
+			Description: "List of IDs of the allow lists assigned to the cluster. Do not use together with the ybm_cluster_allow_list_attachment resource for the same cluster.",
 			Type: types.ListType{
 				ElemType: types.StringType,
 			},
@@ -771,6 +666,108 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			Type:               types.StringType,
 			Optional:           true,
 		},
+		"clone_from": {
+			Description: "Create the cluster as a clone of a backup of another cluster. The backup is restored right after the cluster is created. The block cannot be added or changed afterwards, only removed.",
+			Optional:    true,
+			Validators: []tfsdk.AttributeValidator{
+				schemavalidator.ConflictsWith(path.MatchRoot("restore_backup_id")),
+			},
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"source_cluster_id": {
+					Description: "The ID of the cluster whose backup is restored.",
+					Type:        types.StringType,
+					Required:    true,
+					Validators:  nonEmptyStringValidators(),
+				},
+				"most_recent": {
+					Description: "Set to true to restore the most recent successful backup of the source cluster.",
+					Type:        types.BoolType,
+					Optional:    true,
+					Validators: []tfsdk.AttributeValidator{
+						schemavalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("timestamp"),
+							path.MatchRelative().AtParent().AtName("backup_id"),
+						),
+					},
+				},
+				"timestamp": {
+					Description: "Restore the most recent successful backup of the source cluster taken at or before this time, in RFC 3339 format, for example 2024-05-01T00:00:00Z.",
+					Type:        types.StringType,
+					Optional:    true,
+				},
+				"backup_id": {
+					Description: "The ID of the backup of the source cluster to restore.",
+					Type:        types.StringType,
+					Optional:    true,
+					Validators:  nonEmptyStringValidators(),
+				},
+				"restored_backup_id": {
+					Description: "The ID of the backup that was restored to create the cluster.",
+					Type:        types.StringType,
+					Computed:    true,
+					PlanModifiers: []tfsdk.AttributePlanModifier{
+						tfsdk.UseStateForUnknown(),
+					},
+				},
+				"use_roles": {
+					Description: "Restore global YSQL roles. Defaults to false.",
+					Type:        types.BoolType,
+					Optional:    true,
+				},
+				"ysql_databases": {
+					Description: "List of YSQL databases to restore. If empty or omitted, all YSQL databases are restored.",
+					Type: types.ListType{
+						ElemType: types.StringType,
+					},
+					Optional:   true,
+					Validators: listOfNonEmptyStringValidators(),
+				},
+				"ycql_keyspaces": {
+					Description: "List of YCQL keyspaces to restore. If empty or omitted, all YCQL keyspaces are restored.",
+					Type: types.ListType{
+						ElemType: types.StringType,
+					},
+					Optional:   true,
+					Validators: listOfNonEmptyStringValidators(),
+				},
+				"ysql_databases_rename": {
+					Description: "List of YSQL database renames (backup_database -> restore_database).",
+					Optional:    true,
+					Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+						"backup_database": {
+							Description: "YSQL database name in the backup.",
+							Type:        types.StringType,
+							Required:    true,
+							Validators:  nonEmptyStringValidators(),
+						},
+						"restore_database": {
+							Description: "YSQL database name to use on the cloned cluster.",
+							Type:        types.StringType,
+							Required:    true,
+							Validators:  nonEmptyStringValidators(),
+						},
+					}),
+				},
+				"ycql_keyspaces_rename": {
+					Description: "List of YCQL keyspace renames (backup_database -> restore_database).",
+					Optional:    true,
+					Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+						"backup_database": {
+							Description: "YCQL keyspace name in the backup.",
+							Type:        types.StringType,
+							Required:    true,
+							Validators:  nonEmptyStringValidators(),
+						},
+						"restore_database": {
+							Description: "YCQL keyspace name to use on the cloned cluster.",
+							Type:        types.StringType,
+							Required:    true,
+							Validators:  nonEmptyStringValidators(),
+						},
+					}),
+				},
+			}),
+		},
 		"node_config": {
 			Optional: true,
 			Computed: true,
@@ -788,7 +785,7 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					Optional:    true,
 					Validators: []tfsdk.AttributeValidator{
 						schemavalidator.ConflictsWith(
-							path.MatchRelative().AtParent().AtParent().AtName("cluster_region_info").AtAnyListIndex().AtName("disk_size_gb"),
+							path.MatchRelative().AtParent().AtParent().AtName("cluster_region_info").AtAnyMapKey().AtName("disk_size_gb"),
 						),
 					},
 				},
@@ -799,7 +796,7 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					Optional:    true,
 					Validators: []tfsdk.AttributeValidator{
 						schemavalidator.ConflictsWith(
-							path.MatchRelative().AtParent().AtParent().AtName("cluster_region_info").AtAnyListIndex().AtName("disk_iops"),
+							path.MatchRelative().AtParent().AtParent().AtName("cluster_region_info").AtAnyMapKey().AtName("disk_iops"),
 						),
 					},
 				},
@@ -961,6 +958,8 @@ func (r resourceClusterType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 single- and multi-region clusters. You can also use this resource to bind allow lists to the cluster 
 being created; restore previously taken backups to the cluster being created; 
 and modify the backup schedule of the cluster being created.`,
+		// Version 1 keys cluster_region_info by region code instead of listing the regions
+		Version:    1,
 		Attributes: attributes,
 	}, nil
 }
@@ -976,6 +975,30 @@ type resourceCluster struct {
 }
 
 var _ tfsdk.ResourceWithValidateConfig = resourceCluster{}
+var _ tfsdk.ResourceWithModifyPlan = resourceCluster{}
+var _ tfsdk.ResourceWithUpgradeState = resourceCluster{}
+
+// UpgradeState converts the state of clusters created before cluster_region_info was keyed by
+// region code. The regions keep all their attributes, only the list becomes a map.
+func (r resourceCluster) UpgradeState(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+	return map[int64]tfsdk.ResourceStateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to upgrade the cluster state", "The prior state of the cluster is not in JSON format.")
+					return
+				}
+				upgradedState, err := util.UpgradeListToMapByKey(req.RawState.JSON, "cluster_region_info", "region")
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade the cluster state", err.Error())
+					return
+				}
+				tflog.Debug(ctx, "Cluster state upgraded: cluster_region_info is now keyed by region")
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedState}
+			},
+		},
+	}
+}
 
 func EditBackupSchedule(ctx context.Context, backupScheduleStruct BackupScheduleInfo, scheduleId string, backupDes string, accountId string, projectId string, clusterId string, apiClient *openapiclient.APIClient) error {
 	return editBackupScheduleV2(ctx, backupScheduleStruct, scheduleId, backupDes, accountId, projectId, clusterId, apiClient)
@@ -983,33 +1006,9 @@ func EditBackupSchedule(ctx context.Context, backupScheduleStruct BackupSchedule
 
 func editBackupScheduleV2(ctx context.Context, backupScheduleStruct BackupScheduleInfo, scheduleId string, backupDes string, accountId string, projectId string, clusterId string, apiClient *openapiclient.APIClient) error {
 	if backupScheduleStruct.State.Value != "" && backupScheduleStruct.RetentionPeriodInDays.Value != 0 {
-		backupRetentionPeriodInDays := int32(backupScheduleStruct.RetentionPeriodInDays.Value)
-		backupScheduleSpec := *openapiclient.NewScheduleSpecV2WithDefaults()
-		backupScheduleSpec.SetDescription(backupDes)
-		backupScheduleSpec.SetRetentionPeriodInDays(backupRetentionPeriodInDays)
-		backupScheduleSpec.SetState(openapiclient.ScheduleStateEnum(backupScheduleStruct.State.Value))
-		if !backupScheduleStruct.IncrementalIntervalInMins.IsNull() && !backupScheduleStruct.IncrementalIntervalInMins.IsUnknown() && backupScheduleStruct.IncrementalIntervalInMins.Value != 0 {
-			incrementalIntervalInMins := int32(backupScheduleStruct.IncrementalIntervalInMins.Value)
-			backupScheduleSpec.SetIncrementalIntervalInMinutes(incrementalIntervalInMins)
-		} else {
-			backupScheduleSpec.UnsetIncrementalIntervalInMinutes()
-		}
-		if !backupScheduleStruct.TimeIntervalInDays.IsNull() && !backupScheduleStruct.TimeIntervalInDays.IsUnknown() && backupScheduleStruct.TimeIntervalInDays.Value != 0 {
-			timeIntervalInDays := int32(backupScheduleStruct.TimeIntervalInDays.Value)
-			backupScheduleSpec.SetTimeIntervalInDays(timeIntervalInDays)
-			backupScheduleSpec.UnsetCronExpression()
-		}
-		if backupScheduleStruct.CronExpression.Value != "" {
-			cronExp := backupScheduleStruct.CronExpression.Value
-			backupScheduleSpec.SetCronExpression(cronExp)
-			backupScheduleSpec.UnsetTimeIntervalInDays()
-		}
-		if backupScheduleStruct.TimeIntervalInDays.Value != 0 && backupScheduleStruct.CronExpression.Value != "" {
-			return errors.New("unable to create custom backup schedule. You can't pass both the cron expression and time interval in days")
-		}
-
-		if !backupScheduleStruct.UseRoles.IsNull() && !backupScheduleStruct.UseRoles.IsUnknown() {
-			backupScheduleSpec.SetUseRoles(backupScheduleStruct.UseRoles.Value)
+		backupScheduleSpec, err := createBackupScheduleSpecV2(backupScheduleStruct, backupDes)
+		if err != nil {
+			return err
 		}
 
 		_, res, err := apiClient.BackupApi.ModifyBackupScheduleV2(ctx, accountId, projectId, clusterId, scheduleId).ScheduleSpecV2(backupScheduleSpec).Execute()
@@ -1021,7 +1020,40 @@ func editBackupScheduleV2(ctx context.Context, backupScheduleStruct BackupSchedu
 	return nil
 }
 
-func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, plan Cluster, state Cluster, clusterExists bool) (clusterSpec *openapiclient.ClusterSpec, clusterSpecOK bool, errorMessage string) {
+// createBackupScheduleSpecV2 builds the API spec of a backup schedule with the given description
+func createBackupScheduleSpecV2(backupScheduleStruct BackupScheduleInfo, backupDes string) (openapiclient.ScheduleSpecV2, error) {
+	backupRetentionPeriodInDays := int32(backupScheduleStruct.RetentionPeriodInDays.Value)
+	backupScheduleSpec := *openapiclient.NewScheduleSpecV2WithDefaults()
+	backupScheduleSpec.SetDescription(backupDes)
+	backupScheduleSpec.SetRetentionPeriodInDays(backupRetentionPeriodInDays)
+	backupScheduleSpec.SetState(openapiclient.ScheduleStateEnum(backupScheduleStruct.State.Value))
+	if !backupScheduleStruct.IncrementalIntervalInMins.IsNull() && !backupScheduleStruct.IncrementalIntervalInMins.IsUnknown() && backupScheduleStruct.IncrementalIntervalInMins.Value != 0 {
+		incrementalIntervalInMins := int32(backupScheduleStruct.IncrementalIntervalInMins.Value)
+		backupScheduleSpec.SetIncrementalIntervalInMinutes(incrementalIntervalInMins)
+	} else {
+		backupScheduleSpec.UnsetIncrementalIntervalInMinutes()
+	}
+	if !backupScheduleStruct.TimeIntervalInDays.IsNull() && !backupScheduleStruct.TimeIntervalInDays.IsUnknown() && backupScheduleStruct.TimeIntervalInDays.Value != 0 {
+		timeIntervalInDays := int32(backupScheduleStruct.TimeIntervalInDays.Value)
+		backupScheduleSpec.SetTimeIntervalInDays(timeIntervalInDays)
+		backupScheduleSpec.UnsetCronExpression()
+	}
+	if backupScheduleStruct.CronExpression.Value != "" {
+		cronExp := backupScheduleStruct.CronExpression.Value
+		backupScheduleSpec.SetCronExpression(cronExp)
+		backupScheduleSpec.UnsetTimeIntervalInDays()
+	}
+	if backupScheduleStruct.TimeIntervalInDays.Value != 0 && backupScheduleStruct.CronExpression.Value != "" {
+		return backupScheduleSpec, errors.New("unable to create custom backup schedule. You can't pass both the cron expression and time interval in days")
+	}
+
+	if !backupScheduleStruct.UseRoles.IsNull() && !backupScheduleStruct.UseRoles.IsUnknown() {
+		backupScheduleSpec.SetUseRoles(backupScheduleStruct.UseRoles.Value)
+	}
+	return backupScheduleSpec, nil
+}
+
+func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient, catalog *nodeConfigCatalog, accountId string, projectId string, plan Cluster, state Cluster, clusterExists bool) (clusterSpec *openapiclient.ClusterSpec, clusterSpecOK bool, errorMessage string) {
 	var diskSizeGb int32
 	var diskSizeOK bool
 	var memoryMb int32
@@ -1047,6 +1079,7 @@ func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient,
 	clusterType := plan.ClusterType.Value
 	isDefaultSet := false
 	pseInfoMap := make(map[string]openapiclient.PrivateServiceEndpointRegionSpec)
+	currentDiskSizeMap := make(map[string]int32)
 	if clusterExists {
 		clusterResp, response, err := apiClient.ClusterApi.GetCluster(context.Background(), accountId, projectId, state.ClusterID.Value).Execute()
 		if err != nil {
@@ -1057,12 +1090,22 @@ func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient,
 			if slices.Contains(regionInfo.GetAccessibilityTypes(), openapiclient.ACCESSIBILITYTYPE_PRIVATE_SERVICE_ENDPOINT) {
 				pseInfoMap[regionInfo.PlacementInfo.CloudInfo.GetRegion()] = regionInfo.GetPrivateServiceEndpointInfo()
 			}
+			currentDiskSizeMap[regionInfo.PlacementInfo.CloudInfo.GetRegion()] = regionInfo.NodeInfo.Get().GetDiskSizeGb()
 
 		}
 	}
 	tflog.Debug(ctx, fmt.Sprintf("PSE info map is %v", pseInfoMap))
 
-	for _, regionInfo := range plan.ClusterRegionInfo {
+	// Fetch the node configurations of all the regions at once, the lookups below hit the cache
+	var regions []string
+	for _, regionInfo := range regionInfoList(plan.ClusterRegionInfo) {
+		regions = append(regions, regionInfo.Region.Value)
+	}
+	if _, catalogOK, message := catalog.get(ctx, apiClient, accountId, plan.CloudType.Value, plan.ClusterTier.Value, regions); !catalogOK {
+		return nil, false, message
+	}
+
+	for _, regionInfo := range regionInfoList(plan.ClusterRegionInfo) {
 		regionNodes := regionInfo.NumNodes.Value
 		totalNodes += int(regionNodes)
 		info := *openapiclient.NewClusterRegionInfo(
@@ -1091,7 +1134,7 @@ func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient,
 			numCores = int32(regionInfo.NumCores.Value)
 		}
 
-		memoryMb, memoryOK, message = getMemoryFromInstanceType(ctx, apiClient, accountId, cloud, tier, region, numCores)
+		memoryMb, memoryOK, message = getMemoryFromInstanceType(ctx, apiClient, catalog, accountId, cloud, tier, region, numCores)
 		if !memoryOK {
 			return nil, false, message
 		}
@@ -1101,12 +1144,19 @@ func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient,
 		} else if plan.NodeConfig != nil && !plan.NodeConfig.DiskSizeGb.IsUnknown() && !plan.NodeConfig.DiskSizeGb.IsNull() {
 			diskSizeGb = int32(plan.NodeConfig.DiskSizeGb.Value)
 		} else {
-			diskSizeGb, diskSizeOK, message = getDiskSizeFromInstanceType(ctx, apiClient, accountId, cloud, tier, region, numCores)
+			diskSizeGb, diskSizeOK, message = getDiskSizeFromInstanceType(ctx, apiClient, catalog, accountId, cloud, tier, region, numCores)
 			if !diskSizeOK {
 				return nil, false, message
 			}
 		}
 
+		// Disks grown by storage autoscaling cannot be shrunk back to the configured size.
+		if currentDiskSize, ok := currentDiskSizeMap[region]; ok && isStorageAutoscalingEnabled(plan.StorageAutoscaling) &&
+			util.IsAutoscaledDiskSize(int64(diskSizeGb), int64(currentDiskSize), plan.StorageAutoscaling.MaxDiskSizeGb.Value) {
+			tflog.Debug(ctx, fmt.Sprintf("Keeping autoscaled disk size %v GB in region %v", currentDiskSize, region))
+			diskSizeGb = currentDiskSize
+		}
+
 		nodeInfo := *openapiclient.NewOptionalClusterNodeInfo(numCores, memoryMb, diskSizeGb)
 		if !regionInfo.DiskIops.IsUnknown() && !regionInfo.DiskIops.IsNull() && int32(regionInfo.DiskIops.Value) > 0 {
 			nodeInfo.SetDiskIops(int32(regionInfo.DiskIops.Value))
@@ -1217,6 +1267,20 @@ func createClusterSpec(ctx context.Context, apiClient *openapiclient.APIClient,
 		}
 	}
 
+	if plan.StorageAutoscaling != nil {
+		clusterInfo.SetStorageAutoscaling(*openapiclient.NewStorageAutoscalingSpec(
+			plan.StorageAutoscaling.Enabled.Value,
+			int32(plan.StorageAutoscaling.ThresholdPercentage.Value),
+			int32(plan.StorageAutoscaling.IncrementGb.Value),
+			int32(plan.StorageAutoscaling.MaxDiskSizeGb.Value),
+		))
+	} else if clusterExists && isStorageAutoscalingEnabled(state.StorageAutoscaling) {
+		// The block was removed from the configuration, so turn the policy off
+		storageAutoscaling := *openapiclient.NewStorageAutoscalingSpecWithDefaults()
+		storageAutoscaling.SetEnabled(false)
+		clusterInfo.SetStorageAutoscaling(storageAutoscaling)
+	}
+
 	clusterInfo.SetClusterType(openapiclient.ClusterType(clusterType))
 	if clusterExists {
 		clusterVersion, _ := strconv.Atoi(plan.ClusterVersion.Value)
@@ -1298,6 +1362,7 @@ func getPlan(ctx context.Context, plan tfsdk.Plan, cluster *Cluster) diag.Diagno
 	diags.Append(plan.GetAttribute(ctx, path.Root("cluster_tier"), &cluster.ClusterTier)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("cluster_allow_list_ids"), &cluster.ClusterAllowListIDs)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("restore_backup_id"), &cluster.RestoreBackupID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("clone_from"), &cluster.CloneFrom)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("database_track"), &cluster.DatabaseTrack)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("desired_state"), &cluster.DesiredState)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("desired_connection_pooling_state"), &cluster.DesiredConnectionPoolingState)...)
@@ -1316,6 +1381,7 @@ func getPlan(ctx context.Context, plan tfsdk.Plan, cluster *Cluster) diag.Diagno
 
 	diags.Append(plan.GetAttribute(ctx, path.Root("backup_schedules"), &cluster.BackupSchedules)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("cmk_spec"), &cluster.CMKSpec)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("storage_autoscaling"), &cluster.StorageAutoscaling)...)
 
 	backupReplicationSpec, backupDiags := getPlanBackupReplicationSpec(ctx, plan)
 	diags.Append(backupDiags...)
@@ -1415,8 +1481,12 @@ func getIDsFromState(ctx context.Context, state tfsdk.State, cluster *Cluster) {
 	state.GetAttribute(ctx, path.Root("cluster_region_info"), &cluster.ClusterRegionInfo)
 	state.GetAttribute(ctx, path.Root("backup_schedules"), &cluster.BackupSchedules)
 	state.GetAttribute(ctx, path.Root("restore_backup_id"), &cluster.RestoreBackupID)
+	state.GetAttribute(ctx, path.Root("clone_from"), &cluster.CloneFrom)
 	state.GetAttribute(ctx, path.Root("credentials"), &cluster.Credentials)
 	state.GetAttribute(ctx, path.Root("backup_replication_spec"), &cluster.BackupReplicationSpec)
+	state.GetAttribute(ctx, path.Root("storage_autoscaling"), &cluster.StorageAutoscaling)
+	state.GetAttribute(ctx, path.Root("node_config"), &cluster.NodeConfig)
+	state.GetAttribute(ctx, path.Root("cmk_spec"), &cluster.CMKSpec)
 }
 
 func validateCredentials(credentials *Credentials, isCreateCluster bool) bool {
@@ -1444,16 +1514,16 @@ func validateCredentials(credentials *Credentials, isCreateCluster bool) bool {
 
 }
 
-func validateOnlyOneCMKSpec(plan *Cluster) error {
+func validateOnlyOneCMKSpec(spec *CMKSpec) error {
 	count := 0
 
-	if plan.CMKSpec.GCPCMKSpec != nil {
+	if spec.GCPCMKSpec != nil {
 		count++
 	}
-	if plan.CMKSpec.AWSCMKSpec != nil {
+	if spec.AWSCMKSpec != nil {
 		count++
 	}
-	if plan.CMKSpec.AzureCMKSpec != nil {
+	if spec.AzureCMKSpec != nil {
 		count++
 	}
 
@@ -1464,24 +1534,24 @@ func validateOnlyOneCMKSpec(plan *Cluster) error {
 	return nil
 }
 
-func createCmkSpec(plan Cluster) (*openapiclient.CMKSpec, error) {
-	cmkProvider := plan.CMKSpec.ProviderType.Value
+func createCmkSpec(spec *CMKSpec) (*openapiclient.CMKSpec, error) {
+	cmkProvider := spec.ProviderType.Value
 	cmkSpec := openapiclient.NewCMKSpec(openapiclient.CMKProviderEnum(cmkProvider))
 
-	if err := validateOnlyOneCMKSpec(&plan); err != nil {
+	if err := validateOnlyOneCMKSpec(spec); err != nil {
 		return nil, err
 	}
 
 	switch cmkProvider {
 	case "GCP":
-		if plan.CMKSpec.GCPCMKSpec == nil {
+		if spec.GCPCMKSpec == nil {
 			return nil, errors.New("provider type is GCP but GCP CMK spec is missing")
 		}
-		gcpKeyRingName := plan.CMKSpec.GCPCMKSpec.KeyRingName.Value
-		gcpKeyName := plan.CMKSpec.GCPCMKSpec.KeyName.Value
-		gcpLocation := plan.CMKSpec.GCPCMKSpec.Location.Value
-		gcpProtectionLevel := plan.CMKSpec.GCPCMKSpec.ProtectionLevel.Value
-		gcpServiceAccount := plan.CMKSpec.GCPCMKSpec.GcpServiceAccount
+		gcpKeyRingName := spec.GCPCMKSpec.KeyRingName.Value
+		gcpKeyName := spec.GCPCMKSpec.KeyName.Value
+		gcpLocation := spec.GCPCMKSpec.Location.Value
+		gcpProtectionLevel := spec.GCPCMKSpec.ProtectionLevel.Value
+		gcpServiceAccount := spec.GCPCMKSpec.GcpServiceAccount
 		gcpServiceAccountSpec := openapiclient.NewGCPServiceAccount(
 			gcpServiceAccount.Type.Value,
 			gcpServiceAccount.ProjectId.Value,
@@ -1504,57 +1574,169 @@ func createCmkSpec(plan Cluster) (*openapiclient.CMKSpec, error) {
 		gcpCmkSpec.SetGcpServiceAccount(*gcpServiceAccountSpec)
 		cmkSpec.SetGcpCmkSpec(*gcpCmkSpec)
 	case "AWS":
-		if plan.CMKSpec.AWSCMKSpec == nil {
+		if spec.AWSCMKSpec == nil {
 			return nil, errors.New("provider type is AWS but AWS CMK spec is missing")
 		}
-		awsSecretKey := plan.CMKSpec.AWSCMKSpec.SecretKey.Value
-		awsAccessKey := plan.CMKSpec.AWSCMKSpec.AccessKey.Value
-		awsArnList := make([]string, len(plan.CMKSpec.AWSCMKSpec.ARNList))
+		awsSecretKey := spec.AWSCMKSpec.SecretKey.Value
+		awsAccessKey := spec.AWSCMKSpec.AccessKey.Value
+		awsArnList := make([]string, len(spec.AWSCMKSpec.ARNList))
 
-		for i, arn := range plan.CMKSpec.AWSCMKSpec.ARNList {
+		for i, arn := range spec.AWSCMKSpec.ARNList {
 			awsArnList[i] = arn.Value
 		}
 
 		awsCmkSpec := openapiclient.NewAWSCMKSpec(awsAccessKey, awsSecretKey, awsArnList)
 		cmkSpec.SetAwsCmkSpec(*awsCmkSpec)
 	case "AZURE":
-		if plan.CMKSpec.AzureCMKSpec == nil {
+		if spec.AzureCMKSpec == nil {
 			return nil, errors.New("provider type is AZURE but AZURE CMK spec is missing")
 		}
-		azureClientId := plan.CMKSpec.AzureCMKSpec.ClientID.Value
-		azureClientSecret := plan.CMKSpec.AzureCMKSpec.ClientSecret.Value
-		azureTenantId := plan.CMKSpec.AzureCMKSpec.TenantID.Value
-		azureKeyVaultUri := plan.CMKSpec.AzureCMKSpec.KeyVaultUri.Value
-		azureKeyName := plan.CMKSpec.AzureCMKSpec.KeyName.Value
+		azureClientId := spec.AzureCMKSpec.ClientID.Value
+		azureClientSecret := spec.AzureCMKSpec.ClientSecret.Value
+		azureTenantId := spec.AzureCMKSpec.TenantID.Value
+		azureKeyVaultUri := spec.AzureCMKSpec.KeyVaultUri.Value
+		azureKeyName := spec.AzureCMKSpec.KeyName.Value
 
 		azureCmkSpec := openapiclient.NewAzureCMKSpec(azureClientId, azureClientSecret, azureTenantId, azureKeyVaultUri, azureKeyName)
 		cmkSpec.SetAzureCmkSpec(*azureCmkSpec)
 	}
 
-	cmkSpec.SetIsEnabled(plan.CMKSpec.IsEnabled.Value)
+	cmkSpec.SetIsEnabled(spec.IsEnabled.Value)
 
 	return cmkSpec, nil
 }
 
+// flattenCMKSpec converts the CMK configuration returned by the API into its Terraform model.
+// The credentials in it are masked by the API. Returns nil for an unknown provider type.
+func flattenCMKSpec(cmkDataSpec *openapiclient.CMKSpec) *CMKSpec {
+	cmkSpec := CMKSpec{}
+
+	cmkSpec.ProviderType = types.String{Value: string(cmkDataSpec.GetProviderType())}
+	cmkSpec.IsEnabled = types.Bool{Value: cmkDataSpec.GetIsEnabled()}
+
+	switch cmkSpec.ProviderType.Value {
+	case "AWS":
+		awsCMKSpec := AWSCMKSpec{
+			AccessKey: types.String{Value: cmkDataSpec.GetAwsCmkSpec().AccessKey},
+			SecretKey: types.String{Value: cmkDataSpec.GetAwsCmkSpec().SecretKey},
+			ARNList:   []types.String{},
+		}
+		cmkSpec.AWSCMKSpec = &awsCMKSpec
+
+		for _, arn := range cmkDataSpec.GetAwsCmkSpec().ArnList {
+			cmkSpec.AWSCMKSpec.ARNList = append(cmkSpec.AWSCMKSpec.ARNList, types.String{Value: arn})
+		}
+		return &cmkSpec
+
+	case "GCP":
+		gcpCMKSpec := GCPCMKSpec{
+			KeyRingName:     types.String{Value: cmkDataSpec.GetGcpCmkSpec().KeyRingName},
+			KeyName:         types.String{Value: cmkDataSpec.GetGcpCmkSpec().KeyName},
+			Location:        types.String{Value: cmkDataSpec.GetGcpCmkSpec().Location},
+			ProtectionLevel: types.String{Value: cmkDataSpec.GetGcpCmkSpec().ProtectionLevel},
+		}
+
+		if cmkDataSpec.GetGcpCmkSpec().GcpServiceAccount.IsSet() {
+			gcpServiceAccountData := cmkDataSpec.GetGcpCmkSpec().GcpServiceAccount.Get()
+			gcpServiceAccount := GCPServiceAccount{
+				Type:                    types.String{Value: gcpServiceAccountData.Type},
+				ProjectId:               types.String{Value: gcpServiceAccountData.ProjectId},
+				PrivateKeyId:            types.String{Value: gcpServiceAccountData.PrivateKeyId},
+				ClientEmail:             types.String{Value: gcpServiceAccountData.ClientEmail},
+				ClientId:                types.String{Value: gcpServiceAccountData.ClientId},
+				AuthUri:                 types.String{Value: gcpServiceAccountData.AuthUri},
+				TokenUri:                types.String{Value: gcpServiceAccountData.TokenUri},
+				AuthProviderX509CertUrl: types.String{Value: gcpServiceAccountData.AuthProviderX509CertUrl},
+				ClientX509CertUrl:       types.String{Value: gcpServiceAccountData.ClientX509CertUrl},
+			}
+			if gcpServiceAccountData.GetPrivateKey() != "" {
+				gcpServiceAccount.PrivateKey = types.String{Value: gcpServiceAccountData.GetPrivateKey()}
+			}
+			if gcpServiceAccountData.GetUniverseDomain() != "" {
+				gcpServiceAccount.UniverseDomain = types.String{Value: gcpServiceAccountData.GetUniverseDomain()}
+			} else {
+				gcpServiceAccount.UniverseDomain.Null = true
+			}
+			gcpCMKSpec.GcpServiceAccount = gcpServiceAccount
+		}
+
+		cmkSpec.GCPCMKSpec = &gcpCMKSpec
+		return &cmkSpec
+	case "AZURE":
+		azureCMKSpec := AzureCMKSpec{
+			ClientID:     types.String{Value: cmkDataSpec.GetAzureCmkSpec().ClientId},
+			ClientSecret: types.String{Value: cmkDataSpec.GetAzureCmkSpec().ClientSecret},
+			TenantID:     types.String{Value: cmkDataSpec.GetAzureCmkSpec().TenantId},
+			KeyVaultUri:  types.String{Value: cmkDataSpec.GetAzureCmkSpec().KeyVaultUri},
+			KeyName:      types.String{Value: cmkDataSpec.GetAzureCmkSpec().KeyName},
+		}
+
+		cmkSpec.AzureCMKSpec = &azureCMKSpec
+		return &cmkSpec
+	}
+	return nil
+}
+
 func (r resourceCluster) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
-	// With dynamically typed vars (e.g. list(any)), cluster_region_info can be wholly
-	// unknown here; decoding into []RegionInfo then fails with "unhandled unknown value".
-	var clusterRegionInfoList types.List
-	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_region_info"), &clusterRegionInfoList)...)
+	// With dynamically typed vars (e.g. map(any)), cluster_region_info can be wholly
+	// unknown here; decoding into map[string]RegionInfo then fails with "unhandled unknown value".
+	var clusterRegionInfoMap types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_region_info"), &clusterRegionInfoMap)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !clusterRegionInfoList.IsNull() && !clusterRegionInfoList.IsUnknown() {
-		var clusterRegionInfo []RegionInfo
-		resp.Diagnostics.Append(clusterRegionInfoList.ElementsAs(ctx, &clusterRegionInfo, false)...)
+	diskSizes := map[string]int64{}
+	if !clusterRegionInfoMap.IsNull() && !clusterRegionInfoMap.IsUnknown() {
+		var clusterRegionInfo map[string]RegionInfo
+		resp.Diagnostics.Append(clusterRegionInfoMap.ElementsAs(ctx, &clusterRegionInfo, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		if err := validateMultiZoneSupport(clusterRegionInfo); err != nil {
+		for region, regionInfo := range clusterRegionInfo {
+			if !regionInfo.Region.IsNull() && !regionInfo.Region.IsUnknown() && regionInfo.Region.Value != region {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("cluster_region_info").AtMapKey(region).AtName("region"),
+					"Invalid region",
+					fmt.Sprintf("The region %v does not match the key %v it is configured under. Either remove the attribute or set it to the key.", regionInfo.Region.Value, region),
+				)
+			}
+		}
+
+		if err := validateMultiZoneSupport(regionInfoList(clusterRegionInfo)); err != nil {
 			resp.Diagnostics.AddError("Invalid num_zones field", err.Error())
 		}
+
+		resp.Diagnostics.Append(validateClusterTopologyConfig(ctx, req.Config, clusterRegionInfo)...)
+
+		for region, regionInfo := range clusterRegionInfo {
+			if !regionInfo.DiskSizeGb.IsNull() && !regionInfo.DiskSizeGb.IsUnknown() {
+				diskSizes[region] = regionInfo.DiskSizeGb.Value
+			}
+		}
+	}
+
+	var nodeConfigDiskSize types.Int64
+	req.Config.GetAttribute(ctx, path.Root("node_config").AtName("disk_size_gb"), &nodeConfigDiskSize)
+	if !nodeConfigDiskSize.IsNull() && !nodeConfigDiskSize.IsUnknown() {
+		diskSizes["node_config"] = nodeConfigDiskSize.Value
+	}
+
+	var storageAutoscalingObject types.Object
+	var clusterTier types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("storage_autoscaling"), &storageAutoscalingObject)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_tier"), &clusterTier)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !storageAutoscalingObject.IsNull() && !storageAutoscalingObject.IsUnknown() {
+		var storageAutoscaling StorageAutoscaling
+		resp.Diagnostics.Append(storageAutoscalingObject.As(ctx, &storageAutoscaling, types.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(validateStorageAutoscaling(&storageAutoscaling, clusterTier, diskSizes)...)
 	}
 
 	var isMultiCloud types.Bool
@@ -1566,39 +1748,227 @@ func (r resourceCluster) ValidateConfig(ctx context.Context, req tfsdk.ValidateR
 	if err := validateMultiCloudSupport(isMultiCloud); err != nil {
 		resp.Diagnostics.AddError("Invalid is_multi_cloud field", err.Error())
 	}
+
+	resp.Diagnostics.Append(validateCMKSpecConfig(ctx, req.Config, path.Root("cmk_spec"))...)
+
+	var mostRecent types.Bool
+	var timestamp types.String
+	req.Config.GetAttribute(ctx, path.Root("clone_from").AtName("most_recent"), &mostRecent)
+	req.Config.GetAttribute(ctx, path.Root("clone_from").AtName("timestamp"), &timestamp)
+	if !mostRecent.IsNull() && !mostRecent.IsUnknown() && !mostRecent.Value {
+		resp.Diagnostics.AddAttributeError(path.Root("clone_from").AtName("most_recent"), "Invalid most_recent",
+			"most_recent can only be set to true. Use timestamp or backup_id to clone from an older backup.")
+	}
+	if !timestamp.IsNull() && !timestamp.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, timestamp.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("clone_from").AtName("timestamp"), "Invalid timestamp",
+				fmt.Sprintf("The timestamp %v is not in RFC 3339 format, for example 2024-05-01T00:00:00Z.", timestamp.Value))
+		}
+	}
 }
 
-// Create a new resource
-func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	if !r.p.configured {
-		resp.Diagnostics.AddError(
-			"Provider not configured",
-			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
-		)
-		return
+// validateClusterTopologyConfig checks that the configured regions, nodes and zones can host the
+// replicas of the configured fault tolerance, so that a replication factor change that cannot be
+// placed fails at plan time instead of in the middle of the edit.
+func validateClusterTopologyConfig(ctx context.Context, config tfsdk.Config, clusterRegionInfo map[string]RegionInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var clusterType, faultTolerance types.String
+	var numFaultsToTolerate types.Int64
+	diags.Append(config.GetAttribute(ctx, path.Root("cluster_type"), &clusterType)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("fault_tolerance"), &faultTolerance)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("num_faults_to_tolerate"), &numFaultsToTolerate)...)
+	if diags.HasError() || clusterType.IsUnknown() || faultTolerance.IsUnknown() || faultTolerance.IsNull() || numFaultsToTolerate.IsUnknown() {
+		return diags
 	}
-	var accountId, message string
-	var plan Cluster
-	var getAccountOK bool
-	resp.Diagnostics.Append(getPlan(ctx, req.Plan, &plan)...)
-	if resp.Diagnostics.HasError() {
-		tflog.Debug(ctx, "Cluster Resource: Error on Get Plan")
-		return
+
+	// The server tolerates a single fault unless told otherwise
+	numFaults := int64(1)
+	if faultTolerance.Value == "NONE" {
+		numFaults = 0
+	}
+	if !numFaultsToTolerate.IsNull() {
+		numFaults = numFaultsToTolerate.Value
 	}
 
-	if plan.BackupReplicationSpec != nil && plan.BackupReplicationSpec.GCPSpec != nil {
-		if !isGcpBackupReplicationFeatureEnabled() {
-			resp.Diagnostics.AddError("GCP backup replication feature disabled", "The backup_replication_spec block is gated by the GCP_BACKUP_REPLICATION feature flag. Set YBM_FF_GCP_BACKUP_REPLICATION=true to enable it.")
-			return
-		}
-		if err := validateGcpBackupReplicationPlan(plan); err != nil {
-			resp.Diagnostics.AddError("Invalid backup_replication_spec", err.Error())
-			return
+	var regions []util.RegionTopology
+	for _, regionInfo := range regionInfoList(clusterRegionInfo) {
+		if regionInfo.NumNodes.IsUnknown() || regionInfo.NumZones.IsUnknown() {
+			return diags
 		}
+		regions = append(regions, util.RegionTopology{
+			Region:   regionInfo.Region.Value,
+			NumNodes: regionInfo.NumNodes.Value,
+			NumZones: regionInfo.NumZones.Value,
+		})
 	}
 
-	if !validateCredentials(plan.Credentials, true /* isCreateCluster*/) {
-		resp.Diagnostics.AddError("Invalid credentials", `Please provide 'username' and 'password' 
+	for _, message := range util.ValidateClusterTopology(clusterType.Value, faultTolerance.Value, numFaults, regions) {
+		diags.AddAttributeError(path.Root("fault_tolerance"), "Invalid cluster topology", message)
+	}
+	return diags
+}
+
+// ModifyPlan validates the node configuration of every region against the node configuration
+// catalogue so that unsupported core, disk and IOPS combinations are reported by terraform plan
+// instead of failing during apply. A tier change is checked against the catalogue of the new tier.
+func (r resourceCluster) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Nothing to validate when the cluster is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// FREE clusters can be upgraded to PAID in place, but not the other way around
+	if !req.State.Raw.IsNull() {
+		var planClusterTier, stateClusterTier types.String
+		req.Plan.GetAttribute(ctx, path.Root("cluster_tier"), &planClusterTier)
+		req.State.GetAttribute(ctx, path.Root("cluster_tier"), &stateClusterTier)
+		if !planClusterTier.IsUnknown() && !stateClusterTier.IsNull() {
+			if isValid, message := util.IsClusterTierChangeValid(stateClusterTier.Value, planClusterTier.Value); !isValid {
+				resp.Diagnostics.AddAttributeError(path.Root("cluster_tier"), "Unsupported cluster tier change", message)
+				return
+			}
+		}
+
+		// The backup is only restored when the cluster is created. Removing clone_from is allowed.
+		var planCloneFrom, stateCloneFrom types.Object
+		req.Plan.GetAttribute(ctx, path.Root("clone_from"), &planCloneFrom)
+		req.State.GetAttribute(ctx, path.Root("clone_from"), &stateCloneFrom)
+		if !planCloneFrom.IsNull() && !planCloneFrom.Equal(stateCloneFrom) {
+			resp.Diagnostics.AddAttributeError(path.Root("clone_from"), "Unsupported clone_from change",
+				"clone_from can only be set when the cluster is created. Remove the change, or restore the backup into the existing cluster with ybm_backup_restore.")
+			return
+		}
+	}
+
+	// The catalogue can only be fetched once the provider is configured
+	if !r.p.configured {
+		return
+	}
+
+	var cloudType, clusterTier types.String
+	var planRegionInfoMap, configRegionInfoMap types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cloud_type"), &cloudType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster_tier"), &clusterTier)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cluster_region_info"), &planRegionInfoMap)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_region_info"), &configRegionInfoMap)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cloudType.IsUnknown() || cloudType.IsNull() || clusterTier.IsUnknown() || configRegionInfoMap.IsUnknown() || configRegionInfoMap.IsNull() {
+		return
+	}
+
+	// Skip the catalogue lookup when none of the node configuration inputs changed
+	if !req.State.Raw.IsNull() {
+		var stateCloudType, stateClusterTier types.String
+		var stateRegionInfoMap types.Map
+		var planNodeConfig, stateNodeConfig types.Object
+		req.State.GetAttribute(ctx, path.Root("cloud_type"), &stateCloudType)
+		req.State.GetAttribute(ctx, path.Root("cluster_tier"), &stateClusterTier)
+		req.State.GetAttribute(ctx, path.Root("cluster_region_info"), &stateRegionInfoMap)
+		req.State.GetAttribute(ctx, path.Root("node_config"), &stateNodeConfig)
+		req.Plan.GetAttribute(ctx, path.Root("node_config"), &planNodeConfig)
+		if stateCloudType.Equal(cloudType) && stateClusterTier.Equal(clusterTier) &&
+			stateRegionInfoMap.Equal(planRegionInfoMap) && stateNodeConfig.Equal(planNodeConfig) {
+			return
+		}
+	}
+
+	var clusterRegionInfo map[string]RegionInfo
+	resp.Diagnostics.Append(configRegionInfoMap.ElementsAs(ctx, &clusterRegionInfo, false)...)
+	var nodeConfig *NodeConfig
+	var nodeConfigObject types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node_config"), &nodeConfigObject)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !nodeConfigObject.IsNull() && !nodeConfigObject.IsUnknown() {
+		nodeConfig = &NodeConfig{}
+		resp.Diagnostics.Append(nodeConfigObject.As(ctx, nodeConfig, types.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var regions []string
+	for region := range clusterRegionInfo {
+		regions = append(regions, region)
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	catalog, catalogOK, message := r.p.nodeConfigCatalog.get(ctx, apiClient, accountId, cloudType.Value, clusterTier.Value, regions)
+	if !catalogOK {
+		resp.Diagnostics.AddError("Unable to get the supported node configurations", message)
+		return
+	}
+
+	for _, regionInfo := range regionInfoList(clusterRegionInfo) {
+		region := regionInfo.Region.Value
+		regionPath := path.Root("cluster_region_info").AtMapKey(region)
+		attributePaths := map[string]path.Path{
+			"num_cores":    regionPath.AtName("num_cores"),
+			"disk_size_gb": regionPath.AtName("disk_size_gb"),
+			"disk_iops":    regionPath.AtName("disk_iops"),
+		}
+		numCores, diskSizeGb, diskIops := regionInfo.NumCores, regionInfo.DiskSizeGb, regionInfo.DiskIops
+		// Values not set on the region come from the deprecated root level node_config
+		if nodeConfig != nil {
+			if numCores.IsNull() {
+				numCores = nodeConfig.NumCores
+				attributePaths["num_cores"] = path.Root("node_config").AtName("num_cores")
+			}
+			if diskSizeGb.IsNull() {
+				diskSizeGb = nodeConfig.DiskSizeGb
+				attributePaths["disk_size_gb"] = path.Root("node_config").AtName("disk_size_gb")
+			}
+			if diskIops.IsNull() {
+				diskIops = nodeConfig.DiskIops
+				attributePaths["disk_iops"] = path.Root("node_config").AtName("disk_iops")
+			}
+		}
+
+		for _, nodeConfigError := range util.ValidateNodeConfig(catalog[region], cloudType.Value, clusterTier.Value, region, numCores, diskSizeGb, diskIops) {
+			resp.Diagnostics.AddAttributeError(attributePaths[nodeConfigError.Attribute], nodeConfigError.Summary, nodeConfigError.Detail)
+		}
+	}
+}
+
+// Create a new resource
+func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+	var accountId, message string
+	var plan Cluster
+	var getAccountOK bool
+	resp.Diagnostics.Append(getPlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, "Cluster Resource: Error on Get Plan")
+		return
+	}
+
+	if plan.BackupReplicationSpec != nil && plan.BackupReplicationSpec.GCPSpec != nil {
+		if !isGcpBackupReplicationFeatureEnabled() {
+			resp.Diagnostics.AddError("GCP backup replication feature disabled", "The backup_replication_spec block is gated by the GCP_BACKUP_REPLICATION feature flag. Set YBM_FF_GCP_BACKUP_REPLICATION=true to enable it.")
+			return
+		}
+		if err := validateGcpBackupReplicationPlan(plan); err != nil {
+			resp.Diagnostics.AddError("Invalid backup_replication_spec", err.Error())
+			return
+		}
+	}
+
+	if !validateCredentials(plan.Credentials, true /* isCreateCluster*/) {
+		resp.Diagnostics.AddError("Invalid credentials", `Please provide 'username' and 'password' 
 (which would be used in common for both YSQL and YCQL) OR all of 'ysql_username',
 'ysql_password', 'ycql_username' and 'ycql_password' but not a mix of both.`)
 		return
@@ -1634,7 +2004,7 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
-	for _, regionInfo := range plan.ClusterRegionInfo {
+	for _, regionInfo := range regionInfoList(plan.ClusterRegionInfo) {
 		if !regionInfo.DiskSizeGb.IsUnknown() && !util.IsDiskSizeValid(plan.ClusterTier.Value, regionInfo.DiskSizeGb.Value) {
 			resp.Diagnostics.AddError("Invalid disk size in "+regionInfo.Region.Value, "The disk size for a paid cluster must be at least 50 GB.")
 			return
@@ -1664,7 +2034,19 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
-	clusterSpec, clusterOK, message := createClusterSpec(ctx, apiClient, accountId, projectId, plan, Cluster{}, false)
+	// Resolve the backup to clone from before creating the cluster, so that a missing backup
+	// does not leave an empty cluster behind
+	if plan.CloneFrom != nil {
+		cloneBackupId, cloneBackupOK, message := resolveCloneBackupId(ctx, accountId, projectId, plan.CloneFrom, apiClient)
+		if !cloneBackupOK {
+			resp.Diagnostics.AddAttributeError(path.Root("clone_from"), "Unable to find the backup to clone from", message)
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Cloning cluster %v from backup %v", plan.CloneFrom.SourceClusterID.Value, cloneBackupId))
+		plan.CloneFrom.RestoredBackupID = types.String{Value: cloneBackupId}
+	}
+
+	clusterSpec, clusterOK, message := createClusterSpec(ctx, apiClient, r.p.nodeConfigCatalog, accountId, projectId, plan, Cluster{}, false)
 	if !clusterOK {
 		resp.Diagnostics.AddError("Unable to create cluster spec", message)
 		return
@@ -1698,7 +2080,7 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 			)
 		}
 		var err error
-		cmkSpec, err = createCmkSpec(plan)
+		cmkSpec, err = createCmkSpec(plan.CMKSpec)
 
 		if err == nil {
 			createClusterRequest.SecurityCmkSpec = *openapiclient.NewNullableCMKSpec(cmkSpec)
@@ -1772,10 +2154,11 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 	scheduleId := ""
 	description := ""
 	var r1 *http.Response
-	scheduleId, description, r1, err = getBackupScheduleInfoV2(ctx, apiClient, accountId, projectId, clusterId)
+	// The cluster was just created, so its only schedule is the default one
+	scheduleId, description, r1, err = getBackupScheduleInfoV2(ctx, apiClient, accountId, projectId, clusterId, "")
 
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to fetch the backup schedule for the cluster "+r1.Status, "Try again")
+		resp.Diagnostics.AddError("Unable to fetch the backup schedule for the cluster", getErrorMessage(r1, err))
 		return
 	}
 
@@ -1849,13 +2232,21 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		)
 	}
 	if restoreRequired {
-		err = handleRestore(ctx, accountId, projectId, clusterId, backupId, apiClient)
+		err = handleRestore(ctx, accountId, projectId, wholeClusterRestoreSpec(clusterId, backupId), apiClient)
 		if err != nil {
 			resp.Diagnostics.AddError("Error during store: ", err.Error())
 			return
 		}
 	}
 
+	if plan.CloneFrom != nil {
+		err = handleRestore(ctx, accountId, projectId, cloneRestoreSpec(clusterId, plan.CloneFrom), apiClient)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to clone the cluster", err.Error())
+			return
+		}
+	}
+
 	if isGcpBackupReplicationFeatureEnabled() && hasGcpBackupReplicationPlan(plan.BackupReplicationSpec) {
 		err = r.applyGcpBackupReplication(ctx, accountId, projectId, clusterId, plan, apiClient)
 		if err != nil {
@@ -1864,11 +2255,6 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		}
 	}
 
-	var regions []string
-	for _, regionInfo := range plan.ClusterRegionInfo {
-		regions = append(regions, regionInfo.Region.Value)
-	}
-
 	// Pause the cluster if the desired state is set to 'Paused'
 	if !plan.DesiredState.Unknown && strings.EqualFold(plan.DesiredState.Value, "Paused") {
 		err := pauseCluster(ctx, apiClient, accountId, projectId, clusterId)
@@ -1881,7 +2267,7 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 	// No need for post-creation enablement call
 
 	priorDatabaseTrack := databaseTrackPriorValue(plan.DatabaseTrack)
-	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedules, regions, allowListProvided, allowListIDs, false, priorDatabaseTrack, apiClient)
+	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedules, allowListProvided, allowListIDs, false, priorDatabaseTrack, apiClient)
 
 	// Update the State file with the unmasked creds for AWS (secret key,access) and GCP (client id,private key)
 	if plan.CMKSpec != nil {
@@ -1903,6 +2289,9 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		case "AZURE":
 			cluster.CMKSpec.AzureCMKSpec.ClientSecret = types.String{Value: cmkSpec.GetAzureCmkSpec().ClientSecret}
 		}
+	} else {
+		// Without cmk_spec, the customer managed key is managed by a ybm_cluster_cmk resource
+		cluster.CMKSpec = nil
 	}
 
 	if !readOK {
@@ -1944,6 +2333,7 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 	} else {
 		cluster.RestoreBackupID = types.String{Null: true}
 	}
+	cluster.CloneFrom = plan.CloneFrom
 
 	if strings.EqualFold(plan.DesiredConnectionPoolingState.Value, "Enabled") || strings.EqualFold(plan.DesiredConnectionPoolingState.Value, "Disabled") {
 		cluster.DesiredConnectionPoolingState.Value = plan.DesiredConnectionPoolingState.Value
@@ -1957,6 +2347,8 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 	// We need to make sure the region order is preserved to avoid terraform treating re-order as state mismatch
 	alignGcpBackupReplicationRegionOrder(&cluster, getGeoGcpReplicationRegionReferenceOrder(plan.BackupReplicationSpec))
 
+	reconcileStorageAutoscalingState(&cluster, plan)
+
 	diags := resp.State.Set(ctx, &cluster)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1964,17 +2356,55 @@ func (r resourceCluster) Create(ctx context.Context, req tfsdk.CreateResourceReq
 	}
 }
 
-func getBackupScheduleInfoV2(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, clusterId string) (string, string, *http.Response, error) {
+// clusterBackupSchedule is the ID and description of a backup schedule of a cluster.
+type clusterBackupSchedule struct {
+	id          string
+	description string
+}
+
+// getBackupScheduleInfoV2 returns the ID and description of the schedule managed through the
+// backup_schedules attribute of the cluster: the schedule with the given ID, or the default
+// schedule of the cluster when scheduleId is empty.
+func getBackupScheduleInfoV2(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, clusterId string, scheduleId string) (string, string, *http.Response, error) {
 
 	scheduleResp, r, err := apiClient.BackupApi.ListBackupSchedulesV2(ctx, accountId, projectId, clusterId).Execute()
 	if err != nil {
 		return "", "", r, err
 	}
-	list := scheduleResp.GetData()
-	scheduleId := list[0].GetInfo().Id
-	spec := list[0].GetSpec()
-	description := spec.GetDescription()
-	return scheduleId, description, nil, nil
+	var schedules []clusterBackupSchedule
+	for _, schedule := range scheduleResp.GetData() {
+		spec := schedule.GetSpec()
+		schedules = append(schedules, clusterBackupSchedule{
+			id:          schedule.GetInfo().Id,
+			description: spec.GetDescription(),
+		})
+	}
+	schedule, err := selectClusterBackupSchedule(schedules, scheduleId)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return schedule.id, schedule.description, nil, nil
+}
+
+// selectClusterBackupSchedule picks the schedule with the given ID. Without an ID, the cluster
+// must only have its default schedule: the other schedules may belong to ybm_backup_schedule
+// resources and must not be edited by the cluster resource.
+func selectClusterBackupSchedule(schedules []clusterBackupSchedule, scheduleId string) (clusterBackupSchedule, error) {
+	if scheduleId != "" {
+		for _, schedule := range schedules {
+			if schedule.id == scheduleId {
+				return schedule, nil
+			}
+		}
+		return clusterBackupSchedule{}, fmt.Errorf("the backup schedule %v of the cluster was not found", scheduleId)
+	}
+	switch len(schedules) {
+	case 0:
+		return clusterBackupSchedule{}, errors.New("the cluster has no backup schedule")
+	case 1:
+		return schedules[0], nil
+	}
+	return clusterBackupSchedule{}, fmt.Errorf("the cluster has %d backup schedules; set backup_schedules[0].schedule_id to the ID of the schedule to manage", len(schedules))
 }
 
 func pauseCluster(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, clusterId string) (err error) {
@@ -2191,8 +2621,24 @@ func getRestoreState(ctx context.Context, accountId string, projectId string, cl
 
 // Read resource information
 func (r resourceCluster) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	cluster, readOK, message := readClusterOnPriorState(ctx, req.State, r.p.client)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the state of the cluster", message)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &cluster)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readClusterOnPriorState reads the cluster and keeps the values of the prior state the API
+// does not return, such as the credentials and the unmasked customer managed key secrets.
+func readClusterOnPriorState(ctx context.Context, priorState tfsdk.State, apiClient *openapiclient.APIClient) (cluster Cluster, readOK bool, errorMessage string) {
 	var state Cluster
-	getIDsFromState(ctx, req.State, &state)
+	getIDsFromState(ctx, priorState, &state)
 
 	var allowListIDs []string
 	allowListProvided := false
@@ -2203,23 +2649,21 @@ func (r resourceCluster) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 		}
 	}
 
-	var regions []string
-	for _, regionInfo := range state.ClusterRegionInfo {
-		regions = append(regions, regionInfo.Region.Value)
-	}
-
 	var backUpSchedules []BackupScheduleInfo
 	if len(state.BackupSchedules) > 0 {
 		backUpSchedules = append(backUpSchedules, state.BackupSchedules[0])
 	}
 
 	priorDatabaseTrack := databaseTrackPriorValue(state.DatabaseTrack)
-	cluster, readOK, message := resourceClusterRead(ctx, state.ClusterID.Value, backUpSchedules, regions, allowListProvided, allowListIDs, true, priorDatabaseTrack, r.p.client)
+	cluster, readOK, message := resourceClusterRead(ctx, state.ClusterID.Value, backUpSchedules, allowListProvided, allowListIDs, true, priorDatabaseTrack, apiClient)
+	if !readOK {
+		return cluster, false, message
+	}
 	tflog.Debug(ctx, "Cluster Read: Allow List IDs read from API server", map[string]interface{}{
 		"Allow List IDs": cluster.ClusterAllowListIDs})
 	// Fetch the cmkSpec information from State (to get unmasked creds)
 	var cmkSpec CMKSpec
-	req.State.GetAttribute(ctx, path.Root("cmk_spec"), &cmkSpec)
+	priorState.GetAttribute(ctx, path.Root("cmk_spec"), &cmkSpec)
 
 	if cluster.CMKSpec != nil {
 		// Unmask the creds to store in the State file
@@ -2241,37 +2685,52 @@ func (r resourceCluster) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 			}
 		}
 	}
-
-	if !readOK {
-		resp.Diagnostics.AddError("Unable to read the state of the cluster", message)
-		return
+	// Without cmk_spec, the customer managed key is managed by a ybm_cluster_cmk resource.
+	// It is only read into cmk_spec on import, when the state has no regions yet.
+	if state.CMKSpec == nil && state.ClusterRegionInfo != nil {
+		cluster.CMKSpec = nil
 	}
 
 	// We need to make sure the region order is preserved to avoid terraform treating re-order as state mismatch
 	alignGcpBackupReplicationRegionOrder(&cluster, getGeoGcpReplicationRegionReferenceOrder(state.BackupReplicationSpec))
 
-	tflog.Debug(ctx, "Cluster Read: Allow List IDs read from API server", map[string]interface{}{
-		"Allow List IDs": cluster.ClusterAllowListIDs})
+	reconcileStorageAutoscalingState(&cluster, state)
 
 	// set credentials for cluster (not returned by read api)
-	req.State.GetAttribute(ctx, path.Root("credentials"), &cluster.Credentials)
+	priorState.GetAttribute(ctx, path.Root("credentials"), &cluster.Credentials)
 	// set restore backup id for cluster (not returned by read api)
-	req.State.GetAttribute(ctx, path.Root("restore_backup_id"), &cluster.RestoreBackupID)
+	priorState.GetAttribute(ctx, path.Root("restore_backup_id"), &cluster.RestoreBackupID)
+	// set the clone source for cluster (not returned by read api)
+	priorState.GetAttribute(ctx, path.Root("clone_from"), &cluster.CloneFrom)
 
 	// Workaround: the read API currently always returns is_multi_cloud=false.
 	// Until that bug is fixed (tracked separately), keep the value already in state.
 	// It is null only on import, where the API value is all we have.
 	var stateIsMultiCloud types.Bool
-	req.State.GetAttribute(ctx, path.Root("is_multi_cloud"), &stateIsMultiCloud)
+	priorState.GetAttribute(ctx, path.Root("is_multi_cloud"), &stateIsMultiCloud)
 	if !stateIsMultiCloud.IsNull() {
 		cluster.IsMultiCloud = stateIsMultiCloud
 	}
 
-	diags := resp.State.Set(ctx, &cluster)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	return cluster, true, ""
+}
+
+// regionInfoList returns the regions of the cluster ordered by region code, with the region
+// filled in from the map key for regions that do not set it explicitly.
+func regionInfoList(clusterRegionInfo map[string]RegionInfo) []RegionInfo {
+	regions := make([]string, 0, len(clusterRegionInfo))
+	for region := range clusterRegionInfo {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	regionInfoList := make([]RegionInfo, 0, len(regions))
+	for _, region := range regions {
+		regionInfo := clusterRegionInfo[region]
+		regionInfo.Region = types.String{Value: region}
+		regionInfoList = append(regionInfoList, regionInfo)
 	}
+	return regionInfoList
 }
 
 func getClusterRegionIndex(region string, regionIndexMap map[string]int, localIndex int) (index int) {
@@ -2316,7 +2775,7 @@ func readBackupScheduleInfoV2(ctx context.Context, apiClient *openapiclient.APIC
 	return backupScheduleInfo, nil, nil
 }
 
-func resourceClusterRead(ctx context.Context, clusterId string, backUpSchedules []BackupScheduleInfo, regions []string, allowListProvided bool, inputAllowListIDs []string, readOnly bool, priorDatabaseTrack string, apiClient *openapiclient.APIClient) (cluster Cluster, readOK bool, errorMessage string) {
+func resourceClusterRead(ctx context.Context, clusterId string, backUpSchedules []BackupScheduleInfo, allowListProvided bool, inputAllowListIDs []string, readOnly bool, priorDatabaseTrack string, apiClient *openapiclient.APIClient) (cluster Cluster, readOK bool, errorMessage string) {
 	var accountId, projectId, message string
 	var getAccountOK, getProjectOK bool
 
@@ -2365,72 +2824,7 @@ func resourceClusterRead(ctx context.Context, clusterId string, backUpSchedules
 		return cluster, false, errMsg
 	}
 	if cmkResp.Data != nil {
-		cmkSpec := CMKSpec{}
-		cmkDataSpec := cmkResp.GetData().Spec.Get()
-
-		cmkSpec.ProviderType = types.String{Value: string(cmkDataSpec.GetProviderType())}
-		cmkSpec.IsEnabled = types.Bool{Value: cmkDataSpec.GetIsEnabled()}
-
-		switch cmkSpec.ProviderType.Value {
-		case "AWS":
-			awsCMKSpec := AWSCMKSpec{
-				AccessKey: types.String{Value: cmkDataSpec.GetAwsCmkSpec().AccessKey},
-				SecretKey: types.String{Value: cmkDataSpec.GetAwsCmkSpec().SecretKey},
-				ARNList:   []types.String{},
-			}
-			cmkSpec.AWSCMKSpec = &awsCMKSpec
-
-			for _, arn := range cmkDataSpec.GetAwsCmkSpec().ArnList {
-				cmkSpec.AWSCMKSpec.ARNList = append(cmkSpec.AWSCMKSpec.ARNList, types.String{Value: arn})
-			}
-			cluster.CMKSpec = &cmkSpec
-
-		case "GCP":
-			gcpCMKSpec := GCPCMKSpec{
-				KeyRingName:     types.String{Value: cmkDataSpec.GetGcpCmkSpec().KeyRingName},
-				KeyName:         types.String{Value: cmkDataSpec.GetGcpCmkSpec().KeyName},
-				Location:        types.String{Value: cmkDataSpec.GetGcpCmkSpec().Location},
-				ProtectionLevel: types.String{Value: cmkDataSpec.GetGcpCmkSpec().ProtectionLevel},
-			}
-
-			if cmkDataSpec.GetGcpCmkSpec().GcpServiceAccount.IsSet() {
-				gcpServiceAccountData := cmkDataSpec.GetGcpCmkSpec().GcpServiceAccount.Get()
-				gcpServiceAccount := GCPServiceAccount{
-					Type:                    types.String{Value: gcpServiceAccountData.Type},
-					ProjectId:               types.String{Value: gcpServiceAccountData.ProjectId},
-					PrivateKeyId:            types.String{Value: gcpServiceAccountData.PrivateKeyId},
-					ClientEmail:             types.String{Value: gcpServiceAccountData.ClientEmail},
-					ClientId:                types.String{Value: gcpServiceAccountData.ClientId},
-					AuthUri:                 types.String{Value: gcpServiceAccountData.AuthUri},
-					TokenUri:                types.String{Value: gcpServiceAccountData.TokenUri},
-					AuthProviderX509CertUrl: types.String{Value: gcpServiceAccountData.AuthProviderX509CertUrl},
-					ClientX509CertUrl:       types.String{Value: gcpServiceAccountData.ClientX509CertUrl},
-				}
-				if gcpServiceAccountData.GetPrivateKey() != "" {
-					gcpServiceAccount.PrivateKey = types.String{Value: gcpServiceAccountData.GetPrivateKey()}
-				}
-				if gcpServiceAccountData.GetUniverseDomain() != "" {
-					gcpServiceAccount.UniverseDomain = types.String{Value: gcpServiceAccountData.GetUniverseDomain()}
-				} else {
-					gcpServiceAccount.UniverseDomain.Null = true
-				}
-				gcpCMKSpec.GcpServiceAccount = gcpServiceAccount
-			}
-
-			cmkSpec.GCPCMKSpec = &gcpCMKSpec
-			cluster.CMKSpec = &cmkSpec
-		case "AZURE":
-			azureCMKSpec := AzureCMKSpec{
-				ClientID:     types.String{Value: cmkDataSpec.GetAzureCmkSpec().ClientId},
-				ClientSecret: types.String{Value: cmkDataSpec.GetAzureCmkSpec().ClientSecret},
-				TenantID:     types.String{Value: cmkDataSpec.GetAzureCmkSpec().TenantId},
-				KeyVaultUri:  types.String{Value: cmkDataSpec.GetAzureCmkSpec().KeyVaultUri},
-				KeyName:      types.String{Value: cmkDataSpec.GetAzureCmkSpec().KeyName},
-			}
-
-			cmkSpec.AzureCMKSpec = &azureCMKSpec
-			cluster.CMKSpec = &cmkSpec
-		}
+		cluster.CMKSpec = flattenCMKSpec(cmkResp.GetData().Spec.Get())
 	}
 
 	// fill with all fields of schema except credentials - credentials are not returned by api call
@@ -2483,6 +2877,16 @@ func resourceClusterRead(ctx context.Context, clusterId string, backUpSchedules
 		}
 	}
 
+	if clusterResp.Data.Spec.ClusterInfo.HasStorageAutoscaling() {
+		storageAutoscaling := clusterResp.Data.Spec.ClusterInfo.GetStorageAutoscaling()
+		cluster.StorageAutoscaling = &StorageAutoscaling{
+			Enabled:             types.Bool{Value: storageAutoscaling.GetEnabled()},
+			ThresholdPercentage: types.Int64{Value: int64(storageAutoscaling.GetThresholdPercentage())},
+			IncrementGb:         types.Int64{Value: int64(storageAutoscaling.GetIncrementGb())},
+			MaxDiskSizeGb:       types.Int64{Value: int64(storageAutoscaling.GetMaxDiskSizeGb())},
+		}
+	}
+
 	cluster.ClusterInfo.State.Value = string(clusterResp.Data.Info.GetState())
 	cluster.ClusterInfo.SoftwareVersion.Value = clusterResp.Data.Info.GetSoftwareVersion()
 	cluster.ClusterInfo.CreatedTime.Value = clusterResp.Data.Info.Metadata.Get().GetCreatedOn()
@@ -2520,80 +2924,73 @@ func resourceClusterRead(ctx context.Context, clusterId string, backUpSchedules
 	}
 	cluster.ClusterCertificate.Value = *certResponse.Data
 
-	// This is being done to preserve order in the region list since an order mismatch is treated as state mismatch by Terraform
-	regionIndexMap := map[string]int{}
-	for index, region := range regions {
-		regionIndexMap[region] = index
-	}
-
+	// Regions are keyed by region code, so the order the API returns them in does not matter
 	respClusterRegionInfo := clusterResp.Data.Spec.ClusterRegionInfo
-	clusterRegionInfo := make([]RegionInfo, len(respClusterRegionInfo))
-	for localIndex, info := range respClusterRegionInfo {
+	clusterRegionInfo := make(map[string]RegionInfo, len(respClusterRegionInfo))
+	for _, info := range respClusterRegionInfo {
 		region := info.PlacementInfo.CloudInfo.GetRegion()
-		destIndex := getClusterRegionIndex(region, regionIndexMap, localIndex)
-		if destIndex < len(respClusterRegionInfo) {
-			vpcID := info.PlacementInfo.GetVpcId()
-			vpcName := ""
-			if vpcID != "" {
-				vpcData, err := getVPCByID(context.Background(), accountId, projectId, info.PlacementInfo.GetVpcId(), apiClient)
-				if err != nil {
-					return cluster, false, err.Error()
-				}
-				vpcName = vpcData.Spec.Name
-			}
-
-			// if info.AccessibilityTypes contains "PUBLIC" then set PublicAccess to true
-			publicAccess := false
-			for _, accessibilityType := range info.GetAccessibilityTypes() {
-				if accessibilityType == "PUBLIC" {
-					publicAccess = true
-					break
-				}
+		vpcID := info.PlacementInfo.GetVpcId()
+		vpcName := ""
+		if vpcID != "" {
+			vpcData, err := getVPCByID(context.Background(), accountId, projectId, info.PlacementInfo.GetVpcId(), apiClient)
+			if err != nil {
+				return cluster, false, err.Error()
 			}
+			vpcName = vpcData.Spec.Name
+		}
 
-			tflog.Debug(ctx, fmt.Sprintf("For region %v, publicAccess = %v", region, publicAccess))
-
-			var backupReplicationGCPTarget types.String
-			if info.HasBackupReplicationGcpTarget() && len(info.GetBackupReplicationGcpTarget()) > 0 {
-				backupReplicationGCPTarget = types.String{Value: info.GetBackupReplicationGcpTarget()}
-			} else {
-				backupReplicationGCPTarget = types.String{Null: true}
+		// if info.AccessibilityTypes contains "PUBLIC" then set PublicAccess to true
+		publicAccess := false
+		for _, accessibilityType := range info.GetAccessibilityTypes() {
+			if accessibilityType == "PUBLIC" {
+				publicAccess = true
+				break
 			}
+		}
 
-			// Handle backup region - get from cluster_region_info_details
-			backupRegion := types.Bool{Value: false}
-			// Find the corresponding region info details to get backup_region
-			for _, regionDetail := range clusterResp.Data.Info.ClusterRegionInfoDetails {
-				if regionDetail.Region == region {
-					backupRegion = types.Bool{Value: regionDetail.BackupRegion}
-					break
-				}
-			}
+		tflog.Debug(ctx, fmt.Sprintf("For region %v, publicAccess = %v", region, publicAccess))
 
-			var numZones types.Int64
-			if fflags.IsFeatureFlagEnabled(fflags.MultiZoneSupport) && info.PlacementInfo.HasNumZones() {
-				numZones = types.Int64{Value: int64(info.PlacementInfo.GetNumZones())}
-			} else {
-				numZones = types.Int64{Null: true}
-			}
+		var backupReplicationGCPTarget types.String
+		if info.HasBackupReplicationGcpTarget() && len(info.GetBackupReplicationGcpTarget()) > 0 {
+			backupReplicationGCPTarget = types.String{Value: info.GetBackupReplicationGcpTarget()}
+		} else {
+			backupReplicationGCPTarget = types.String{Null: true}
+		}
 
-			regionInfo := RegionInfo{
-				Region:                     types.String{Value: region},
-				NumNodes:                   types.Int64{Value: int64(info.PlacementInfo.GetNumNodes())},
-				NumZones:                   numZones,
-				NumCores:                   types.Int64{Value: int64(info.NodeInfo.Get().GetNumCores())},
-				DiskSizeGb:                 types.Int64{Value: int64(info.NodeInfo.Get().GetDiskSizeGb())},
-				DiskIops:                   types.Int64{Value: int64(info.NodeInfo.Get().GetDiskIops())},
-				VPCID:                      types.String{Value: vpcID},
-				VPCName:                    types.String{Value: vpcName},
-				PublicAccess:               types.Bool{Value: publicAccess},
-				IsPreferred:                types.Bool{Value: info.GetIsAffinitized()},
-				IsDefault:                  types.Bool{Value: info.GetIsDefault()},
-				BackupReplicationGCPTarget: backupReplicationGCPTarget,
-				BackupRegion:               backupRegion,
+		// Handle backup region - get from cluster_region_info_details
+		backupRegion := types.Bool{Value: false}
+		// Find the corresponding region info details to get backup_region
+		for _, regionDetail := range clusterResp.Data.Info.ClusterRegionInfoDetails {
+			if regionDetail.Region == region {
+				backupRegion = types.Bool{Value: regionDetail.BackupRegion}
+				break
 			}
-			clusterRegionInfo[destIndex] = regionInfo
 		}
+
+		var numZones types.Int64
+		if fflags.IsFeatureFlagEnabled(fflags.MultiZoneSupport) && info.PlacementInfo.HasNumZones() {
+			numZones = types.Int64{Value: int64(info.PlacementInfo.GetNumZones())}
+		} else {
+			numZones = types.Int64{Null: true}
+		}
+
+		regionInfo := RegionInfo{
+			Region:                     types.String{Value: region},
+			NumNodes:                   types.Int64{Value: int64(info.PlacementInfo.GetNumNodes())},
+			NumZones:                   numZones,
+			NumCores:                   types.Int64{Value: int64(info.NodeInfo.Get().GetNumCores())},
+			DiskSizeGb:                 types.Int64{Value: int64(info.NodeInfo.Get().GetDiskSizeGb())},
+			CurrentDiskSizeGb:          types.Int64{Value: int64(info.NodeInfo.Get().GetDiskSizeGb())},
+			DiskIops:                   types.Int64{Value: int64(info.NodeInfo.Get().GetDiskIops())},
+			VPCID:                      types.String{Value: vpcID},
+			VPCName:                    types.String{Value: vpcName},
+			PublicAccess:               types.Bool{Value: publicAccess},
+			IsPreferred:                types.Bool{Value: info.GetIsAffinitized()},
+			IsDefault:                  types.Bool{Value: info.GetIsDefault()},
+			BackupReplicationGCPTarget: backupReplicationGCPTarget,
+			BackupRegion:               backupRegion,
+		}
+		clusterRegionInfo[region] = regionInfo
 	}
 	cluster.ClusterRegionInfo = clusterRegionInfo
 	cluster.CloudType.Value = string(respClusterRegionInfo[0].PlacementInfo.CloudInfo.GetCode())
@@ -2676,10 +3073,88 @@ func getClusterVersion(accountId string, projectId string, clusterId string, api
 	return int(clusterResp.Data.Spec.ClusterInfo.GetVersion()), true, ""
 }
 
-func handleRestore(ctx context.Context, accountId string, projectId string, clusterId string, backupId string, apiClient *openapiclient.APIClient) error {
+// wholeClusterRestoreSpec restores all the databases and keyspaces of a backup to a cluster
+func wholeClusterRestoreSpec(clusterId string, backupId string) openapiclient.RestoreSpec {
 	restoreSpec := *openapiclient.NewRestoreSpec()
 	restoreSpec.SetBackupId(backupId)
 	restoreSpec.SetClusterId(clusterId)
+	return restoreSpec
+}
+
+// cloneRestoreSpec restores the backup resolved for clone_from to the new cluster, with the
+// same database selection and renames as ybm_backup_restore
+func cloneRestoreSpec(clusterId string, cloneFrom *CloneFrom) openapiclient.RestoreSpec {
+	return *buildRestoreSpec(&BackupRestore{
+		BackupID:            cloneFrom.RestoredBackupID,
+		TargetClusterID:     types.String{Value: clusterId},
+		UseRoles:            types.Bool{Value: cloneFrom.UseRoles.Value},
+		YSQLDatabases:       cloneFrom.YSQLDatabases,
+		YCQLKeyspaces:       cloneFrom.YCQLKeyspaces,
+		YSQLDatabasesRename: cloneFrom.YSQLDatabasesRename,
+		YCQLKeyspacesRename: cloneFrom.YCQLKeyspacesRename,
+	})
+}
+
+// resolveCloneBackupId finds the successful backup of the source cluster selected by clone_from.
+// Backups are listed most recent first.
+func resolveCloneBackupId(ctx context.Context, accountId string, projectId string, cloneFrom *CloneFrom, apiClient *openapiclient.APIClient) (string, bool, string) {
+	sourceClusterId := cloneFrom.SourceClusterID.Value
+	var timestamp time.Time
+	if !cloneFrom.Timestamp.IsNull() {
+		var err error
+		timestamp, err = time.Parse(time.RFC3339, cloneFrom.Timestamp.Value)
+		if err != nil {
+			return "", false, fmt.Sprintf("The timestamp %v is not in RFC 3339 format.", cloneFrom.Timestamp.Value)
+		}
+	}
+
+	state := "SUCCEEDED"
+	backupsResp, response, err := apiClient.BackupApi.ListBackups(ctx, accountId, projectId).ClusterId(sourceClusterId).State(state).Execute()
+	for {
+		if err != nil {
+			errMsg := getErrorMessage(response, err)
+			return "", false, errMsg
+		}
+		for _, data := range backupsResp.Data {
+			backupId := data.Info.GetId()
+			switch {
+			case cloneFrom.MostRecent.Value:
+				return backupId, true, ""
+			case !cloneFrom.BackupID.IsNull():
+				if backupId == cloneFrom.BackupID.Value {
+					return backupId, true, ""
+				}
+			default:
+				createdOn, err := time.Parse(time.RFC3339, data.Info.Metadata.Get().GetCreatedOn())
+				if err != nil {
+					tflog.Warn(ctx, fmt.Sprintf("Skipping backup %v with unexpected creation time: %v", backupId, err))
+					continue
+				}
+				if !createdOn.After(timestamp) {
+					return backupId, true, ""
+				}
+			}
+		}
+		if !backupsResp.Metadata.HasContinuationToken() {
+			break
+		}
+		continuationToken := backupsResp.Metadata.GetContinuationToken()
+		backupsResp, response, err = apiClient.BackupApi.ListBackups(ctx, accountId, projectId).ClusterId(sourceClusterId).State(state).ContinuationToken(continuationToken).Execute()
+	}
+
+	switch {
+	case cloneFrom.MostRecent.Value:
+		return "", false, fmt.Sprintf("The cluster %v has no successful backups.", sourceClusterId)
+	case !cloneFrom.BackupID.IsNull():
+		return "", false, fmt.Sprintf("The backup %v is not a successful backup of the cluster %v.", cloneFrom.BackupID.Value, sourceClusterId)
+	default:
+		return "", false, fmt.Sprintf("The cluster %v has no successful backups taken at or before %v.", sourceClusterId, cloneFrom.Timestamp.Value)
+	}
+}
+
+func handleRestore(ctx context.Context, accountId string, projectId string, restoreSpec openapiclient.RestoreSpec, apiClient *openapiclient.APIClient) error {
+	clusterId := restoreSpec.GetClusterId()
+	backupId := restoreSpec.GetBackupId()
 	tflog.Debug(ctx, fmt.Sprintf("Restoring to cluster with cluster ID %v the backup with backup ID %v", clusterId, backupId))
 
 	restoreResp, response, err := apiClient.BackupApi.RestoreBackup(ctx, accountId, projectId).RestoreSpec(restoreSpec).Execute()
@@ -2698,12 +3173,18 @@ func handleRestore(ctx context.Context, accountId string, projectId string, clus
 			if restoreState == "SUCCEEDED" {
 				return nil
 			}
+			if restoreState == "FAILED" {
+				return ErrFailedTask
+			}
 		} else {
 			return retry.RetryableError(errors.New("Unable to get restore state: " + message))
 		}
 		return retry.RetryableError(errors.New("the backup restore is in progress"))
 	})
 
+	if errors.Is(err, ErrFailedTask) {
+		return errors.New("unable to restore backup to the cluster: The backup restore failed")
+	}
 	if err != nil {
 		return errors.New("unable to restore backup to the cluster: The operation timed out waiting for backup restore")
 	}
@@ -2800,7 +3281,7 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		}
 	}
 
-	for _, regionInfo := range plan.ClusterRegionInfo {
+	for _, regionInfo := range regionInfoList(plan.ClusterRegionInfo) {
 		if !regionInfo.DiskSizeGb.IsUnknown() && !util.IsDiskSizeValid(plan.ClusterTier.Value, regionInfo.DiskSizeGb.Value) {
 			resp.Diagnostics.AddError("Invalid disk size in "+regionInfo.Region.Value, "The disk size for a paid cluster must be at least 50 GB.")
 			return
@@ -2815,144 +3296,45 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		}
 	}
 
-	scheduleId := ""
-	backupDescription := ""
-	var r1 *http.Response
 	var err error
 
-	scheduleId, backupDescription, r1, err = getBackupScheduleInfoV2(ctx, apiClient, accountId, projectId, clusterId)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to fetch the backup schedule for the cluster "+r1.Status, "Try again")
-		return
-	}
+	// Changes of the replication factor that also change the nodes are split into phases, so
+	// that there are always enough nodes for the replicas
+	phases := clusterEditPhases(plan, state)
+	for i, phase := range phases {
+		phaseName := fmt.Sprintf("phase %d of %d: %v", i+1, len(phases), phase.description)
+		if len(phases) > 1 {
+			tflog.Info(ctx, fmt.Sprintf("Cluster %v: starting %v", clusterId, phaseName))
+		}
 
-	clusterSpec, clusterOK, message := createClusterSpec(ctx, apiClient, accountId, projectId, plan, state, true)
-	if !clusterOK {
-		resp.Diagnostics.AddError("Unable to create cluster specification ", message)
-		return
-	}
+		clusterSpec, clusterOK, message := createClusterSpec(ctx, apiClient, r.p.nodeConfigCatalog, accountId, projectId, phase.plan, state, true)
+		if !clusterOK {
+			resp.Diagnostics.AddError("Unable to create cluster specification ", message)
+			return
+		}
 
-	clusterVersion, versionOK, message := getClusterVersion(accountId, projectId, clusterId, apiClient)
-	if !versionOK {
-		resp.Diagnostics.AddError("Unable to get cluster version ", message)
-		return
-	}
-	clusterSpec.ClusterInfo.SetVersion(int32(clusterVersion))
-	maxRetries := 5
-	var response *http.Response
-
-	for {
-		_, response, err = apiClient.ClusterApi.EditCluster(ctx, accountId, projectId, clusterId).ClusterSpec(*clusterSpec).Execute()
-		if err != nil {
-			// Retry on 503s
-			if response != nil && response.StatusCode == 503 && maxRetries > 0 {
-				maxRetries--
-				tflog.Warn(ctx, "Received 503 Service Unavailable. Retrying after 10 sec...")
-				time.Sleep(10 * time.Second)
-				continue
-			}
-			errMsg := getErrorMessage(response, err)
-			if len(errMsg) > 10000 {
-				resp.Diagnostics.AddError("Unable to edit cluster. NOTE: The length of the HTML output indicates your authentication token may be out of date. A truncated response follows: ",
-					errMsg[:10000])
-				return
-			}
-			resp.Diagnostics.AddError("Unable to edit cluster ", errMsg)
-			return
-		}
-		break
-	}
-
-	// The following code has a pitfall:
-	// If we change just the cluster_allow_list_ids field, then we will send a cluster edit
-	// request to the server. The server will see the spec is the same as the current spec,
-	// so there will be no task submitted.
-	// If there is no task submitted (EVER), we will get a TASK_NOT_FOUND.
-	// If there was EVER a task submitted, we will get the status of that task (likely SUCCESS).
-	//
-	// Challenges:
-	// 1. Last EDIT was not successful - the customer should first perform an edit to get out of that state.
-	// 2. To work around ANY possible race condition in the server side (task created AFTER the response),
-	// we will try twice to read the task state. If both times we can't find the task, we bail out.
-	//
-	// Something similar will happen if changing the backup schedule or the CMK spec.
-	retries := 0
-	readClusterRetries := 0
-	checkNewTaskSpawned := true
-	retryPolicy := retry.NewConstant(10 * time.Second)
-	editTimeout := time.Duration(max(60, 30*totalNodes)) * time.Minute // 30 minutes per node, Min. 60 minutes
-	retryPolicy = retry.WithMaxDuration(editTimeout, retryPolicy)
-	err = retry.Do(ctx, retryPolicy, func(ctx context.Context) error {
-		asState, readInfoOK, message := getTaskState(accountId, projectId, clusterId, openapiclient.ENTITYTYPEENUM_CLUSTER, openapiclient.TASKTYPEENUM_EDIT_CLUSTER, apiClient, ctx)
-
-		tflog.Info(ctx, "Cluster edit operation in progress, state: "+asState)
-
-		if readInfoOK {
-			if asState == "TASK_NOT_FOUND" {
-				// We try for a minute waiting for the tasks to be spawned. If edit cluster responded with a success
-				// without creating a task for about a minute, we can safely assume that a task is not required to be spawned.
-				// We also test for the cluster to be in an active state in that code that follows. So, we can safely do this.
-				if retries < 6 {
-					retries++
-					tflog.Info(ctx, "Cluster edit task not found, retrying...")
-					return retry.RetryableError(errors.New("cluster not found, retrying"))
-				} else {
-					tflog.Info(ctx, "Cluster edit task not found, the change would not have required a task creation")
-					return nil
-				}
-			}
-			// There are cases this code flow checks for the state of previously spawned tasks instead of checking for new tasks.
-			// Hence, we check whether a new task is spawned.
-			if checkNewTaskSpawned {
-				if asState == string(openapiclient.TASKACTIONSTATEENUM_IN_PROGRESS) {
-					checkNewTaskSpawned = false
-					return retry.RetryableError(errors.New("cluster edit operation in progress"))
-				} else {
-					tflog.Info(ctx, "Cluster edit task not found, the change would not have required a task creation")
-					return nil
-				}
-			}
-			if asState == string(openapiclient.TASKACTIONSTATEENUM_SUCCEEDED) {
-				return nil
-			}
-			if asState == string(openapiclient.TASKACTIONSTATEENUM_FAILED) {
-				return ErrFailedTask
-			}
-
-		} else {
-			return handleReadFailureWithRetries(ctx, &readClusterRetries, 2, message)
-		}
-		return retry.RetryableError(errors.New("cluster edit operation in progress"))
-	})
-
-	if err != nil {
-		msg := "The operation timed out waiting for cluster edit to complete."
-		if errors.Is(err, ErrFailedTask) {
-			msg = "cluster edit operation failed"
-		}
-		resp.Diagnostics.AddError("Unable to edit cluster:", msg)
-		return
-	}
-
-	// read status, wait for status to be active
-	readClusterRetries = 0
-	retryPolicyA := retry.NewConstant(10 * time.Second)
-	retryPolicyA = retry.WithMaxDuration(editTimeout, retryPolicyA)
-	err = retry.Do(ctx, retryPolicyA, func(ctx context.Context) error {
-		clusterState, readInfoOK, message := getClusterState(ctx, accountId, projectId, clusterId, apiClient)
-		if readInfoOK {
-			if strings.EqualFold(clusterState, "Active") || clusterState == "Create Failed" || clusterState == "CREATE_FAILED" {
-				return nil
-			}
-		} else {
-			return handleReadFailureWithRetries(ctx, &readClusterRetries, 2, message)
-		}
-		return retry.RetryableError(errors.New("cluster edit is in progress"))
-	})
-
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to edit cluster:", "The operation timed out waiting for cluster edit to complete.")
-		return
+		phaseNodes := 0
+		for _, regionInfo := range phase.plan.ClusterRegionInfo {
+			phaseNodes += int(regionInfo.NumNodes.Value)
+		}
+		err = editClusterAndWait(ctx, apiClient, accountId, projectId, clusterId, clusterSpec, max(totalNodes, phaseNodes), phaseName)
+		if err != nil {
+			if i > 0 {
+				// Save the cluster as left by the earlier phases, so that the next apply only
+				// plans the remaining changes
+				resp.Diagnostics.AddError(fmt.Sprintf("Unable to edit cluster in %v", phaseName),
+					fmt.Sprintf("%v The earlier phases were applied and saved to the state; run terraform apply again to complete the change.", err.Error()))
+				cluster, readOK, message := readClusterOnPriorState(ctx, req.State, apiClient)
+				if !readOK {
+					resp.Diagnostics.AddError("Unable to read the state of the cluster after the earlier phases", message)
+					return
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &cluster)...)
+				return
+			}
+			resp.Diagnostics.AddError("Unable to edit cluster ", err.Error())
+			return
+		}
 	}
 
 	var backUpSchedules []BackupScheduleInfo
@@ -2969,6 +3351,19 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 			resp.Diagnostics.AddError("Unable to modify backup schedule", "You must provide both state and retention period in days.")
 			return
 		}
+		// Only edit the schedule already managed by the cluster, never one of a ybm_backup_schedule
+		stateScheduleId := ""
+		if len(state.BackupSchedules) > 0 {
+			stateScheduleId = state.BackupSchedules[0].ScheduleID.Value
+		}
+		if !plan.BackupSchedules[0].ScheduleID.IsUnknown() && plan.BackupSchedules[0].ScheduleID.Value != "" {
+			stateScheduleId = plan.BackupSchedules[0].ScheduleID.Value
+		}
+		scheduleId, backupDescription, r1, err := getBackupScheduleInfoV2(ctx, apiClient, accountId, projectId, clusterId, stateScheduleId)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to fetch the backup schedule for the cluster", getErrorMessage(r1, err))
+			return
+		}
 		tflog.Info(ctx, fmt.Sprintf("User defined description '%v' default description '%v'", plan.BackupSchedules[0].BackupDescription.Value, backupDescription))
 		newDescription := ""
 
@@ -2996,7 +3391,7 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 	}
 
 	if plan.CMKSpec != nil {
-		cmkSpec, err := createCmkSpec(plan)
+		cmkSpec, err := createCmkSpec(plan.CMKSpec)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating CMK Spec: ", err.Error())
 			return
@@ -3045,7 +3440,7 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		backupId = plan.RestoreBackupID.Value
 	}
 	if restoreRequired {
-		err = handleRestore(ctx, accountId, projectId, clusterId, backupId, apiClient)
+		err = handleRestore(ctx, accountId, projectId, wholeClusterRestoreSpec(clusterId, backupId), apiClient)
 		if err != nil {
 			resp.Diagnostics.AddError("Error during store: ", err.Error())
 			return
@@ -3070,16 +3465,11 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		}
 	}
 
-	var regions []string
-	for _, regionInfo := range plan.ClusterRegionInfo {
-		regions = append(regions, regionInfo.Region.Value)
-	}
-
 	priorDatabaseTrack := databaseTrackPriorValue(plan.DatabaseTrack)
 	if priorDatabaseTrack == "" {
 		priorDatabaseTrack = databaseTrackPriorValue(state.DatabaseTrack)
 	}
-	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedules, regions, allowListProvided, allowListIDs, false, priorDatabaseTrack, apiClient)
+	cluster, readOK, message := resourceClusterRead(ctx, clusterId, backUpSchedules, allowListProvided, allowListIDs, false, priorDatabaseTrack, apiClient)
 	if !readOK {
 		resp.Diagnostics.AddError("Unable to read the state of the cluster ", message)
 		return
@@ -3106,6 +3496,9 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		case "AZURE":
 			cluster.CMKSpec.AzureCMKSpec.ClientSecret = plan.CMKSpec.AzureCMKSpec.ClientSecret
 		}
+	} else {
+		// Without cmk_spec, the customer managed key is managed by a ybm_cluster_cmk resource
+		cluster.CMKSpec = nil
 	}
 
 	// Set credentials
@@ -3126,6 +3519,16 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		cluster.RestoreBackupID = types.String{Null: true}
 	}
 
+	// The clone source only applies when the cluster is created
+	cluster.CloneFrom = plan.CloneFrom
+	if cluster.CloneFrom != nil {
+		if state.CloneFrom != nil {
+			cluster.CloneFrom.RestoredBackupID = state.CloneFrom.RestoredBackupID
+		} else {
+			cluster.CloneFrom.RestoredBackupID = types.String{Null: true}
+		}
+	}
+
 	if strings.EqualFold(plan.DesiredConnectionPoolingState.Value, "Enabled") || strings.EqualFold(plan.DesiredConnectionPoolingState.Value, "Disabled") {
 		cluster.DesiredConnectionPoolingState.Value = plan.DesiredConnectionPoolingState.Value
 	}
@@ -3133,6 +3536,8 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 	// We need to make sure the region order is preserved to avoid terraform treating re-order as state mismatch
 	alignGcpBackupReplicationRegionOrder(&cluster, getGeoGcpReplicationRegionReferenceOrder(plan.BackupReplicationSpec))
 
+	reconcileStorageAutoscalingState(&cluster, plan)
+
 	diags := resp.State.Set(ctx, &cluster)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3140,6 +3545,189 @@ func (r resourceCluster) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 	}
 }
 
+// editClusterAndWait submits a cluster edit and waits for its task to complete and the cluster to
+// be active again. phase describes the edit in the progress logs.
+func editClusterAndWait(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, clusterId string, clusterSpec *openapiclient.ClusterSpec, totalNodes int, phase string) error {
+	clusterVersion, versionOK, message := getClusterVersion(accountId, projectId, clusterId, apiClient)
+	if !versionOK {
+		return errors.New("Unable to get cluster version: " + message)
+	}
+	clusterSpec.ClusterInfo.SetVersion(int32(clusterVersion))
+	maxRetries := 5
+	var response *http.Response
+	var err error
+
+	for {
+		_, response, err = apiClient.ClusterApi.EditCluster(ctx, accountId, projectId, clusterId).ClusterSpec(*clusterSpec).Execute()
+		if err != nil {
+			// Retry on 503s
+			if response != nil && response.StatusCode == 503 && maxRetries > 0 {
+				maxRetries--
+				tflog.Warn(ctx, "Received 503 Service Unavailable. Retrying after 10 sec...")
+				time.Sleep(10 * time.Second)
+				continue
+			}
+			errMsg := getErrorMessage(response, err)
+			if len(errMsg) > 10000 {
+				return errors.New("NOTE: The length of the HTML output indicates your authentication token may be out of date. A truncated response follows: " + errMsg[:10000])
+			}
+			return errors.New(errMsg)
+		}
+		break
+	}
+
+	// The following code has a pitfall:
+	// If we change just the cluster_allow_list_ids field, then we will send a cluster edit
+	// request to the server. The server will see the spec is the same as the current spec,
+	// so there will be no task submitted.
+	// If there is no task submitted (EVER), we will get a TASK_NOT_FOUND.
+	// If there was EVER a task submitted, we will get the status of that task (likely SUCCESS).
+	//
+	// Challenges:
+	// 1. Last EDIT was not successful - the customer should first perform an edit to get out of that state.
+	// 2. To work around ANY possible race condition in the server side (task created AFTER the response),
+	// we will try twice to read the task state. If both times we can't find the task, we bail out.
+	//
+	// Something similar will happen if changing the backup schedule or the CMK spec.
+	retries := 0
+	readClusterRetries := 0
+	checkNewTaskSpawned := true
+	retryPolicy := retry.NewConstant(10 * time.Second)
+	editTimeout := time.Duration(max(60, 30*totalNodes)) * time.Minute // 30 minutes per node, Min. 60 minutes
+	retryPolicy = retry.WithMaxDuration(editTimeout, retryPolicy)
+	err = retry.Do(ctx, retryPolicy, func(ctx context.Context) error {
+		asState, readInfoOK, message := getTaskState(accountId, projectId, clusterId, openapiclient.ENTITYTYPEENUM_CLUSTER, openapiclient.TASKTYPEENUM_EDIT_CLUSTER, apiClient, ctx)
+
+		tflog.Info(ctx, fmt.Sprintf("Cluster edit operation in progress (%v), state: %v", phase, asState))
+
+		if readInfoOK {
+			if asState == "TASK_NOT_FOUND" {
+				// We try for a minute waiting for the tasks to be spawned. If edit cluster responded with a success
+				// without creating a task for about a minute, we can safely assume that a task is not required to be spawned.
+				// We also test for the cluster to be in an active state in that code that follows. So, we can safely do this.
+				if retries < 6 {
+					retries++
+					tflog.Info(ctx, "Cluster edit task not found, retrying...")
+					return retry.RetryableError(errors.New("cluster not found, retrying"))
+				} else {
+					tflog.Info(ctx, "Cluster edit task not found, the change would not have required a task creation")
+					return nil
+				}
+			}
+			// There are cases this code flow checks for the state of previously spawned tasks instead of checking for new tasks.
+			// Hence, we check whether a new task is spawned.
+			if checkNewTaskSpawned {
+				if asState == string(openapiclient.TASKACTIONSTATEENUM_IN_PROGRESS) {
+					checkNewTaskSpawned = false
+					return retry.RetryableError(errors.New("cluster edit operation in progress"))
+				} else {
+					tflog.Info(ctx, "Cluster edit task not found, the change would not have required a task creation")
+					return nil
+				}
+			}
+			if asState == string(openapiclient.TASKACTIONSTATEENUM_SUCCEEDED) {
+				return nil
+			}
+			if asState == string(openapiclient.TASKACTIONSTATEENUM_FAILED) {
+				return ErrFailedTask
+			}
+
+		} else {
+			return handleReadFailureWithRetries(ctx, &readClusterRetries, 2, message)
+		}
+		return retry.RetryableError(errors.New("cluster edit operation in progress"))
+	})
+
+	if err != nil {
+		msg := "The operation timed out waiting for cluster edit to complete."
+		if errors.Is(err, ErrFailedTask) {
+			msg = "cluster edit operation failed"
+		}
+		return errors.New(msg)
+	}
+
+	// read status, wait for status to be active
+	readClusterRetries = 0
+	retryPolicyA := retry.NewConstant(10 * time.Second)
+	retryPolicyA = retry.WithMaxDuration(editTimeout, retryPolicyA)
+	err = retry.Do(ctx, retryPolicyA, func(ctx context.Context) error {
+		clusterState, readInfoOK, message := getClusterState(ctx, accountId, projectId, clusterId, apiClient)
+		if readInfoOK {
+			if strings.EqualFold(clusterState, "Active") || clusterState == "Create Failed" || clusterState == "CREATE_FAILED" {
+				return nil
+			}
+		} else {
+			return handleReadFailureWithRetries(ctx, &readClusterRetries, 2, message)
+		}
+		return retry.RetryableError(errors.New("cluster edit is in progress"))
+	})
+
+	if err != nil {
+		return errors.New("The operation timed out waiting for cluster edit to complete.")
+	}
+
+	return nil
+}
+
+// clusterEditPhase is one cluster edit of an update, with the plan to send in that edit
+type clusterEditPhase struct {
+	description string
+	plan        Cluster
+}
+
+// clusterEditPhases orders the edits of an update that changes the replication factor. When the
+// replication factor grows, the nodes are added before the fault tolerance is raised; when it
+// shrinks, the fault tolerance is lowered before the nodes are removed. All other updates are a
+// single edit.
+func clusterEditPhases(plan Cluster, state Cluster) []clusterEditPhase {
+	planRF := util.ReplicationFactor(plan.FaultTolerance.Value, plan.NumFaultsToTolerate.Value)
+	stateRF := util.ReplicationFactor(state.FaultTolerance.Value, state.NumFaultsToTolerate.Value)
+	single := []clusterEditPhase{{description: "editing the cluster", plan: plan}}
+	if plan.FaultTolerance.IsUnknown() || plan.NumFaultsToTolerate.IsUnknown() || state.FaultTolerance.IsNull() || planRF == stateRF {
+		return single
+	}
+	if reflect.DeepEqual(regionNodeCounts(plan.ClusterRegionInfo), regionNodeCounts(state.ClusterRegionInfo)) {
+		return single
+	}
+
+	faultToleranceChange := fmt.Sprintf("changing the fault tolerance to %v (replication factor %d)", plan.FaultTolerance.Value, planRF)
+	intermediate := plan
+	if planRF > stateRF {
+		intermediate.FaultTolerance = state.FaultTolerance
+		intermediate.NumFaultsToTolerate = state.NumFaultsToTolerate
+		return []clusterEditPhase{
+			{description: "adding the nodes for the new replicas", plan: intermediate},
+			{description: faultToleranceChange, plan: plan},
+		}
+	}
+
+	// Keep every current region and node until the replication factor is lowered
+	intermediate.ClusterRegionInfo = map[string]RegionInfo{}
+	for region, regionInfo := range plan.ClusterRegionInfo {
+		if stateRegionInfo, ok := state.ClusterRegionInfo[region]; ok && stateRegionInfo.NumNodes.Value > regionInfo.NumNodes.Value {
+			regionInfo.NumNodes = stateRegionInfo.NumNodes
+		}
+		intermediate.ClusterRegionInfo[region] = regionInfo
+	}
+	for region, stateRegionInfo := range state.ClusterRegionInfo {
+		if _, ok := intermediate.ClusterRegionInfo[region]; !ok {
+			intermediate.ClusterRegionInfo[region] = stateRegionInfo
+		}
+	}
+	return []clusterEditPhase{
+		{description: faultToleranceChange, plan: intermediate},
+		{description: "removing the nodes that are no longer needed", plan: plan},
+	}
+}
+
+func regionNodeCounts(clusterRegionInfo map[string]RegionInfo) map[string]int64 {
+	nodeCounts := map[string]int64{}
+	for region, regionInfo := range clusterRegionInfo {
+		nodeCounts[region] = regionInfo.NumNodes.Value
+	}
+	return nodeCounts
+}
+
 // Delete resource
 func (r resourceCluster) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
 	var state Cluster
@@ -3215,6 +3803,59 @@ func validateMultiCloudSupport(isMultiCloud types.Bool) error {
 	return nil
 }
 
+func isStorageAutoscalingEnabled(storageAutoscaling *StorageAutoscaling) bool {
+	return storageAutoscaling != nil && storageAutoscaling.Enabled.Value
+}
+
+func validateStorageAutoscaling(storageAutoscaling *StorageAutoscaling, clusterTier types.String, diskSizes map[string]int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !isStorageAutoscalingEnabled(storageAutoscaling) || storageAutoscaling.IncrementGb.IsUnknown() || storageAutoscaling.MaxDiskSizeGb.IsUnknown() {
+		return diags
+	}
+	if clusterTier.Value == "FREE" {
+		diags.AddAttributeError(path.Root("storage_autoscaling").AtName("enabled"), "Invalid storage_autoscaling",
+			"Storage autoscaling is only supported for PAID clusters.")
+		return diags
+	}
+	for location, diskSize := range diskSizes {
+		if isValid, message := util.IsStorageAutoscalingPolicyValid(diskSize, storageAutoscaling.IncrementGb.Value, storageAutoscaling.MaxDiskSizeGb.Value); !isValid {
+			diags.AddAttributeError(path.Root("storage_autoscaling").AtName("max_disk_size_gb"), "Invalid storage_autoscaling in "+location, message)
+		}
+	}
+	return diags
+}
+
+// reconcileStorageAutoscalingState keeps the configured disk sizes in the cluster state when
+// the server reports disks grown by storage autoscaling within the policy, so that a refresh
+// does not plan a shrink back to the configured size. The actual sizes stay visible through
+// cluster_region_info.current_disk_size_gb.
+func reconcileStorageAutoscalingState(cluster *Cluster, prior Cluster) {
+	if prior.StorageAutoscaling == nil && !isStorageAutoscalingEnabled(cluster.StorageAutoscaling) {
+		// A disabled policy that was never configured should not show up as a diff
+		cluster.StorageAutoscaling = nil
+	}
+	if !isStorageAutoscalingEnabled(cluster.StorageAutoscaling) {
+		return
+	}
+	maxDiskSizeGb := cluster.StorageAutoscaling.MaxDiskSizeGb.Value
+
+	for region, regionInfo := range cluster.ClusterRegionInfo {
+		priorRegionInfo, ok := prior.ClusterRegionInfo[region]
+		if !ok || priorRegionInfo.DiskSizeGb.IsNull() || priorRegionInfo.DiskSizeGb.IsUnknown() {
+			continue
+		}
+		if util.IsAutoscaledDiskSize(priorRegionInfo.DiskSizeGb.Value, regionInfo.DiskSizeGb.Value, maxDiskSizeGb) {
+			regionInfo.DiskSizeGb.Value = priorRegionInfo.DiskSizeGb.Value
+			cluster.ClusterRegionInfo[region] = regionInfo
+		}
+	}
+
+	if cluster.NodeConfig != nil && prior.NodeConfig != nil && !prior.NodeConfig.DiskSizeGb.IsNull() && !prior.NodeConfig.DiskSizeGb.IsUnknown() &&
+		util.IsAutoscaledDiskSize(prior.NodeConfig.DiskSizeGb.Value, cluster.NodeConfig.DiskSizeGb.Value, maxDiskSizeGb) {
+		cluster.NodeConfig.DiskSizeGb.Value = prior.NodeConfig.DiskSizeGb.Value
+	}
+}
+
 func isGcpBackupReplicationFeatureEnabled() bool {
 	return fflags.IsFeatureFlagEnabled(fflags.GCPBackupReplication)
 }
@@ -3251,7 +3892,7 @@ func validateGcpBackupReplicationPlan(plan Cluster) error {
 				return fmt.Errorf("geo_partitioned_cluster_spec.replication_configs must be provided for geo partitioned clusters")
 			}
 			clusterRegions := make(map[string]struct{})
-			for _, region := range plan.ClusterRegionInfo {
+			for _, region := range regionInfoList(plan.ClusterRegionInfo) {
 				regionName := strings.TrimSpace(typesStringValue(region.Region))
 				if regionName != "" {
 					clusterRegions[regionName] = struct{}{}
@@ -3338,12 +3979,10 @@ func expandPlanToGcpSpec(ctx context.Context, apiClient *openapiclient.APIClient
 }
 
 func getPrimaryBackupRegion(ctx context.Context, apiClient *openapiclient.APIClient, accountId, projectId, clusterId string) (string, error) {
-	resp, httpResp, err := apiClient.ClusterApi.GetClusterBackupRegions(ctx, accountId, projectId, clusterId).Execute()
+	regions, err := getClusterBackupRegions(ctx, accountId, projectId, clusterId, apiClient)
 	if err != nil {
-		errMsg := getErrorMessage(httpResp, err)
-		return "", fmt.Errorf("unable to fetch backup region information: %s", errMsg)
+		return "", err
 	}
-	regions := resp.Data.GetBackupRegions()
 	if len(regions) == 0 {
 		return "", fmt.Errorf("no backup regions returned for cluster %s", clusterId)
 	}
@@ -0,0 +1,232 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type resourceClusterAllowListAttachmentType struct{}
+
+func (r resourceClusterAllowListAttachmentType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The resource to attach a single allow list to a cluster in YugabyteDB Aeon. The other allow lists of the cluster are left as they are,
+so several attachments, possibly managed by different modules, can share a cluster.
+Do not use this resource for a cluster whose allow lists are set through the cluster_allow_list_ids attribute of the ybm_cluster resource.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"allow_list_id": {
+				Description: "The ID of the allow list to attach to the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceClusterAllowListAttachmentType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceClusterAllowListAttachment{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceClusterAllowListAttachment struct {
+	p provider
+}
+
+func isAllowListAttachedToCluster(ctx context.Context, accountId string, projectId string, clusterId string, allowListId string, apiClient *openapiclient.APIClient) (attached bool, clusterFound bool, errorMessage string) {
+	clusterNalResp, response, err := apiClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return false, false, ""
+		}
+		return false, false, getErrorMessage(response, err)
+	}
+	for _, allowList := range clusterNalResp.GetData() {
+		if allowList.GetInfo().Id == allowListId {
+			return true, true, ""
+		}
+	}
+	return false, true, ""
+}
+
+func (r resourceClusterAllowListAttachment) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var plan ClusterAllowListAttachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	clusterId := plan.ClusterID.Value
+	allowListId := plan.AllowListID.Value
+	// A concurrent edit of the allow lists of the cluster may overwrite the attachment, so it is
+	// checked once the edit completed and redone if needed.
+	const maxAttempts = 3
+	attached := false
+	for attempt := 1; attempt <= maxAttempts && !attached; attempt++ {
+		err := addAllowListToCluster(ctx, accountId, projectId, clusterId, allowListId, apiClient)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to attach the allow list to the cluster", err.Error())
+			return
+		}
+		var clusterFound bool
+		attached, clusterFound, message = isAllowListAttachedToCluster(ctx, accountId, projectId, clusterId, allowListId, apiClient)
+		if message != "" {
+			resp.Diagnostics.AddError("Unable to read the allow lists of the cluster", message)
+			return
+		}
+		if !clusterFound {
+			resp.Diagnostics.AddError("Unable to attach the allow list to the cluster", fmt.Sprintf("The cluster %v does not exist.", clusterId))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Attempt %d: allow list %v attached to cluster %v: %v", attempt, allowListId, clusterId, attached))
+	}
+	if !attached {
+		resp.Diagnostics.AddError("Unable to attach the allow list to the cluster",
+			fmt.Sprintf("The allow list %v was removed from the cluster %v by concurrent edits of its allow lists.", allowListId, clusterId))
+		return
+	}
+
+	plan.AccountID = types.String{Value: accountId}
+	plan.ProjectID = types.String{Value: projectId}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterAllowListAttachment) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ClusterAllowListAttachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	attached, _, message := isAllowListAttachedToCluster(ctx, accountId, projectId, state.ClusterID.Value, state.AllowListID.Value, apiClient)
+	if message != "" {
+		resp.Diagnostics.AddError("Unable to read the allow lists of the cluster", message)
+		return
+	}
+	if !attached {
+		tflog.Info(ctx, fmt.Sprintf("Allow list %v is no longer attached to cluster %v", state.AllowListID.Value, state.ClusterID.Value))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.AccountID = types.String{Value: accountId}
+	state.ProjectID = types.String{Value: projectId}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterAllowListAttachment) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Every attribute forces a new attachment, there is nothing to update in place
+	var plan ClusterAllowListAttachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterAllowListAttachment) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ClusterAllowListAttachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := removeAllowListFromCluster(ctx, state.AccountID.Value, state.ProjectID.Value, state.ClusterID.Value, state.AllowListID.Value, r.p.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to detach the allow list from the cluster", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceClusterAllowListAttachment) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: allow_list_id,cluster_id. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("allow_list_id"), idParts[0])
+	resp.State.SetAttribute(ctx, path.Root("cluster_id"), idParts[1])
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	mocks "github.com/yugabyte/terraform-provider-ybm/mock_yugabytedb_managed_go_client_internal"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+func getMockClusterAllowListAttachment(cfg *openapiclient.Configuration, mockClusterApi *mocks.MockClusterApi, mockTaskApi *mocks.MockTaskApi, mockAccountApi *mocks.MockAccountApi) *resourceClusterAllowListAttachment {
+	apiClient := openapiclient.NewAPIClient(cfg)
+	apiClient.ClusterApi = mockClusterApi
+	apiClient.TaskApi = mockTaskApi
+	apiClient.AccountApi = mockAccountApi
+
+	return &resourceClusterAllowListAttachment{
+		p: provider{
+			configured: true,
+			client:     apiClient,
+		},
+	}
+}
+
+func TestCreateClusterAllowListAttachment(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockClusterApi := mocks.NewMockClusterApi(mockCtrl)
+	mockTaskApi := mocks.NewMockTaskApi(mockCtrl)
+	mockAccountApi := mocks.NewMockAccountApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	clusterID := "test-cluster-id"
+	allowListID := "test-allow-list-id"
+	otherAllowListID := "test-other-allow-list-id"
+	attachment := getMockClusterAllowListAttachment(cfg, mockClusterApi, mockTaskApi, mockAccountApi)
+
+	testClient := openapiclient.NewAPIClient(cfg)
+	getClusterRequest := testClient.ClusterApi.GetCluster(ctx, accountID, projectID, clusterID)
+	getClusterRequest.ApiService = mockClusterApi
+	listClusterAllowListsRequest := testClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID)
+	listClusterAllowListsRequest.ApiService = mockClusterApi
+	editClusterAllowListsRequest := testClient.ClusterApi.EditClusterNetworkAllowLists(ctx, accountID, projectID, clusterID)
+	editClusterAllowListsRequest.ApiService = mockClusterApi
+	editClusterAllowListsRequestFinal := editClusterAllowListsRequest.RequestBody([]string{otherAllowListID, allowListID})
+	listTasksRequest := testClient.TaskApi.ListTasks(ctx, accountID)
+	listTasksRequest.ApiService = mockTaskApi
+	listTasksRequestFinal := listTasksRequest.TaskType(openapiclient.TASKTYPEENUM_EDIT_ALLOW_LIST).ProjectId(projectID).EntityId(clusterID).Limit(1)
+
+	req := tfsdk.CreateResourceRequest{}
+	attachmentType := resourceClusterAllowListAttachmentType{}
+	schema, _ := attachmentType.GetSchema(ctx)
+	req.Plan.Schema = schema
+	req.Plan.Set(ctx, &ClusterAllowListAttachment{
+		AccountID:   types.String{Unknown: true},
+		ProjectID:   types.String{Unknown: true},
+		ClusterID:   types.String{Value: clusterID},
+		AllowListID: types.String{Value: allowListID},
+	})
+	resp := &tfsdk.CreateResourceResponse{}
+	resp.State.Schema = schema
+
+	desiredState := tfsdk.State{}
+	desiredState.Schema = schema
+	desiredState.Set(ctx, &ClusterAllowListAttachment{
+		AccountID:   types.String{Value: accountID},
+		ProjectID:   types.String{Value: projectID},
+		ClusterID:   types.String{Value: clusterID},
+		AllowListID: types.String{Value: allowListID},
+	})
+
+	httpSuccessResponse := &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+	}
+	httpConflictResponse := &http.Response{
+		Status:     "409 Conflict",
+		StatusCode: http.StatusConflict,
+	}
+
+	testCases := []struct {
+		TestName      string
+		ExpectedState tfsdk.State
+	}{
+		{
+			TestName:      "Edit retried while another edit of the cluster is in progress",
+			ExpectedState: desiredState,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			accountRequest := getCurrentAccountRequest(ctx, cfg, mockAccountApi)
+			accountResponse := getCurrentAccountResponse(accountID, projectID)
+			mockAccountApi.EXPECT().GetCurrentAccount(ctx).Return(*accountRequest).Times(2)
+			mockAccountApi.EXPECT().GetCurrentAccountExecute(*accountRequest).Return(*accountResponse, httpSuccessResponse, nil).Times(2)
+			mockClusterApi.EXPECT().GetCluster(ctx, accountID, projectID, clusterID).Return(getClusterRequest).Times(1)
+			mockClusterApi.EXPECT().GetClusterExecute(getClusterRequest).Return(openapiclient.ClusterResponse{}, httpSuccessResponse, nil).Times(1)
+			// The first edit is rejected with a conflict, the allow lists are read again and the edit retried
+			mockClusterApi.EXPECT().ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID).Return(listClusterAllowListsRequest).Times(3)
+			mockClusterApi.EXPECT().ListClusterNetworkAllowListsExecute(listClusterAllowListsRequest).Return(getClusterAllowListsResponse(projectID, otherAllowListID), httpSuccessResponse, nil).Times(2)
+			mockClusterApi.EXPECT().EditClusterNetworkAllowLists(ctx, accountID, projectID, clusterID).Return(editClusterAllowListsRequest).Times(2)
+			mockClusterApi.EXPECT().EditClusterNetworkAllowListsExecute(editClusterAllowListsRequestFinal).Return(openapiclient.NetworkAllowListListResponse{}, httpConflictResponse, errors.New("409 Conflict")).Times(1)
+			mockClusterApi.EXPECT().EditClusterNetworkAllowListsExecute(editClusterAllowListsRequestFinal).Return(getClusterAllowListsResponse(projectID, otherAllowListID, allowListID), httpSuccessResponse, nil).Times(1)
+			// The attachment is done once the EDIT_ALLOW_LIST task of the cluster succeeded
+			mockTaskApi.EXPECT().ListTasks(ctx, accountID).Return(listTasksRequest).Times(1)
+			mockTaskApi.EXPECT().ListTasksExecute(listTasksRequestFinal).Return(getTaskListResponse(t, "EDIT_ALLOW_LIST", "SUCCEEDED"), httpSuccessResponse, nil).Times(1)
+			mockClusterApi.EXPECT().ListClusterNetworkAllowListsExecute(listClusterAllowListsRequest).Return(getClusterAllowListsResponse(projectID, otherAllowListID, allowListID), httpSuccessResponse, nil).Times(1)
+			attachment.Create(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Got errors: %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(resp.State, testCase.ExpectedState) {
+				t.Errorf("Got State: %v, Expected State: %v", resp.State, testCase.ExpectedState)
+			}
+		})
+	}
+}
+
+func TestReadClusterAllowListAttachment(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockClusterApi := mocks.NewMockClusterApi(mockCtrl)
+	mockTaskApi := mocks.NewMockTaskApi(mockCtrl)
+	mockAccountApi := mocks.NewMockAccountApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	clusterID := "test-cluster-id"
+	allowListID := "test-allow-list-id"
+	attachment := getMockClusterAllowListAttachment(cfg, mockClusterApi, mockTaskApi, mockAccountApi)
+
+	testClient := openapiclient.NewAPIClient(cfg)
+	listClusterAllowListsRequest := testClient.ClusterApi.ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID)
+	listClusterAllowListsRequest.ApiService = mockClusterApi
+
+	attachmentType := resourceClusterAllowListAttachmentType{}
+	schema, _ := attachmentType.GetSchema(ctx)
+	req := tfsdk.ReadResourceRequest{}
+	req.State.Schema = schema
+	inputState := &ClusterAllowListAttachment{
+		AccountID:   types.String{Value: accountID},
+		ProjectID:   types.String{Value: projectID},
+		ClusterID:   types.String{Value: clusterID},
+		AllowListID: types.String{Value: allowListID},
+	}
+	req.State.Set(ctx, inputState)
+
+	attachedState := tfsdk.State{}
+	attachedState.Schema = schema
+	attachedState.Set(ctx, inputState)
+	removedState := tfsdk.State{}
+	removedState.Schema = schema
+	removedState.Set(ctx, inputState)
+	removedState.RemoveResource(ctx)
+
+	httpSuccessResponse := &http.Response{
+		Status:     "200 OK",
+		StatusCode: 200,
+	}
+	httpNotFoundResponse := &http.Response{
+		Status:     "404 Not Found",
+		StatusCode: http.StatusNotFound,
+	}
+
+	testCases := []struct {
+		TestName           string
+		AllowListsResponse openapiclient.NetworkAllowListListResponse
+		HTTPResponse       *http.Response
+		Error              error
+		ExpectedState      tfsdk.State
+	}{
+		{
+			TestName:           "Allow list attached",
+			AllowListsResponse: getClusterAllowListsResponse(projectID, allowListID),
+			HTTPResponse:       httpSuccessResponse,
+			ExpectedState:      attachedState,
+		},
+		{
+			TestName:           "Allow list detached",
+			AllowListsResponse: getClusterAllowListsResponse(projectID),
+			HTTPResponse:       httpSuccessResponse,
+			ExpectedState:      removedState,
+		},
+		{
+			TestName:           "Cluster deleted",
+			AllowListsResponse: openapiclient.NetworkAllowListListResponse{},
+			HTTPResponse:       httpNotFoundResponse,
+			Error:              errors.New("404 Not Found"),
+			ExpectedState:      removedState,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			resp := &tfsdk.ReadResourceResponse{}
+			resp.State = req.State
+
+			accountRequest := getCurrentAccountRequest(ctx, cfg, mockAccountApi)
+			accountResponse := getCurrentAccountResponse(accountID, projectID)
+			mockAccountApi.EXPECT().GetCurrentAccount(ctx).Return(*accountRequest).Times(2)
+			mockAccountApi.EXPECT().GetCurrentAccountExecute(*accountRequest).Return(*accountResponse, httpSuccessResponse, nil).Times(2)
+			mockClusterApi.EXPECT().ListClusterNetworkAllowLists(ctx, accountID, projectID, clusterID).Return(listClusterAllowListsRequest).Times(1)
+			mockClusterApi.EXPECT().ListClusterNetworkAllowListsExecute(listClusterAllowListsRequest).Return(testCase.AllowListsResponse, testCase.HTTPResponse, testCase.Error).Times(1)
+			attachment.Read(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Got errors: %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(resp.State, testCase.ExpectedState) {
+				t.Errorf("Got State: %v, Expected State: %v", resp.State, testCase.ExpectedState)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	retry "github.com/sethvargo/go-retry"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type resourceClusterBackupRegionType struct{}
+
+func (r resourceClusterBackupRegionType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: `The resource to manage the regions a cluster stores its backups in, in YugabyteDB Aeon.
+Synchronous clusters store their backups in exactly one of their regions, geo-partitioned clusters in one or more of their regions.
+Changing the backup regions does not edit the cluster. Removing the resource leaves the backup regions of the cluster as they are.`,
+		Attributes: map[string]tfsdk.Attribute{
+			"account_id": {
+				Description: "The ID of the account this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"project_id": {
+				Description: "The ID of the project this cluster belongs to.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": {
+				Description: "The ID of the cluster.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"backup_regions": {
+				Description: "The regions the backups of the cluster are stored in. Every region must be a region of the cluster_region_info of the cluster.",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+				Required:   true,
+				Validators: []tfsdk.AttributeValidator{setvalidator.SizeAtLeast(1)},
+			},
+		},
+	}, nil
+}
+
+func (r resourceClusterBackupRegionType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceClusterBackupRegion{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceClusterBackupRegion struct {
+	p provider
+}
+
+var _ tfsdk.ResourceWithModifyPlan = resourceClusterBackupRegion{}
+
+// ModifyPlan checks the backup regions against the regions of the cluster, so that a region the
+// cluster is not deployed in fails the plan instead of the apply.
+func (r resourceClusterBackupRegion) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.p.configured {
+		return
+	}
+
+	// The cluster may not exist yet, e.g. when it is created in the same apply
+	for _, attribute := range []string{"cluster_id", "backup_regions"} {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rawValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !rawValue.IsFullyKnown() {
+			return
+		}
+	}
+
+	var plan ClusterBackupRegion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	clusterResp, response, err := apiClient.ClusterApi.GetCluster(ctx, accountId, projectId, plan.ClusterID.Value).Execute()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "Unable to read the cluster", getErrorMessage(response, err))
+		return
+	}
+	clusterSpec := clusterResp.Data.Spec
+	var clusterRegions []string
+	for _, regionInfo := range clusterSpec.ClusterRegionInfo {
+		clusterRegions = append(clusterRegions, regionInfo.PlacementInfo.CloudInfo.GetRegion())
+	}
+
+	clusterType := string(clusterSpec.ClusterInfo.GetClusterType())
+	for _, message := range util.ValidateBackupRegions(clusterType, clusterRegions, util.SliceTypesStringToSliceString(plan.BackupRegions)) {
+		resp.Diagnostics.AddAttributeError(path.Root("backup_regions"), "Invalid backup region", message)
+	}
+}
+
+func getClusterBackupRegions(ctx context.Context, accountId string, projectId string, clusterId string, apiClient *openapiclient.APIClient) ([]string, error) {
+	resp, httpResp, err := apiClient.ClusterApi.GetClusterBackupRegions(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		errMsg := getErrorMessage(httpResp, err)
+		return nil, fmt.Errorf("unable to fetch backup region information: %s", errMsg)
+	}
+	return resp.Data.GetBackupRegions(), nil
+}
+
+func (r resourceClusterBackupRegion) setBackupRegions(ctx context.Context, plan ClusterBackupRegion, diags *diag.Diagnostics) (ClusterBackupRegion, bool) {
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		diags.AddError("Unable to get account ID", message)
+		return plan, false
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		diags.AddError("Unable to get project ID", message)
+		return plan, false
+	}
+
+	clusterId := plan.ClusterID.Value
+	backupRegions := util.SliceTypesStringToSliceString(plan.BackupRegions)
+	tflog.Info(ctx, fmt.Sprintf("Setting the backup regions of cluster %v to %v", clusterId, backupRegions))
+	backupRegionsSpec := openapiclient.NewClusterBackupRegionsSpec(backupRegions)
+	_, response, err := apiClient.ClusterApi.UpdateClusterBackupRegions(ctx, accountId, projectId, clusterId).ClusterBackupRegionsSpec(*backupRegionsSpec).Execute()
+	if err != nil {
+		diags.AddError("Unable to set the backup regions of the cluster", getErrorMessage(response, err))
+		return plan, false
+	}
+
+	// The new backup regions are returned once the cluster switched over to them
+	expectedRegions := append([]string{}, backupRegions...)
+	sort.Strings(expectedRegions)
+	retryPolicy := retry.NewConstant(10 * time.Second)
+	retryPolicy = retry.WithMaxDuration(1800*time.Second, retryPolicy)
+	err = retry.Do(ctx, retryPolicy, func(ctx context.Context) error {
+		currentRegions, err := getClusterBackupRegions(ctx, accountId, projectId, clusterId, apiClient)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		sort.Strings(currentRegions)
+		if !util.AreListsEqual(currentRegions, expectedRegions) {
+			return retry.RetryableError(errors.New("the backup regions of the cluster are being updated"))
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError("Unable to set the backup regions of the cluster", "The operation timed out waiting for the new backup regions: "+err.Error())
+		return plan, false
+	}
+
+	plan.AccountID = types.String{Value: accountId}
+	plan.ProjectID = types.String{Value: projectId}
+	return plan, true
+}
+
+func (r resourceClusterBackupRegion) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var plan ClusterBackupRegion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupRegion, ok := r.setBackupRegions(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &backupRegion)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterBackupRegion) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ClusterBackupRegion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	clusterId := state.ClusterID.Value
+	backupRegionsResp, response, err := apiClient.ClusterApi.GetClusterBackupRegions(ctx, accountId, projectId, clusterId).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, fmt.Sprintf("Cluster %v not found, removing its backup regions from the state", clusterId))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read the backup regions of the cluster", getErrorMessage(response, err))
+		return
+	}
+
+	state.AccountID = types.String{Value: accountId}
+	state.ProjectID = types.String{Value: projectId}
+	state.BackupRegions = util.SliceStringToSliceTypesString(backupRegionsResp.Data.GetBackupRegions())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterBackupRegion) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan ClusterBackupRegion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupRegion, ok := r.setBackupRegions(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &backupRegion)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterBackupRegion) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// A cluster always stores its backups somewhere, the backup regions are left as they are
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceClusterBackupRegion) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
@@ -0,0 +1,603 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+type resourceClusterCMKType struct{}
+
+// cmkSpecSchemaAttributes are the attributes of a customer managed key configuration. They are
+// shared by the ybm_cluster_cmk resource and the deprecated cmk_spec block of ybm_cluster.
+func cmkSpecSchemaAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"provider_type": {
+			Description: "CMK Provider Type.",
+			Type:        types.StringType,
+			Required:    true,
+			Validators:  []tfsdk.AttributeValidator{stringvalidator.OneOf("AWS", "GCP", "AZURE")},
+		},
+		"is_enabled": {
+			Description: "Is Enabled",
+			Type:        types.BoolType,
+			Required:    true,
+		},
+		"aws_cmk_spec": {
+			Description: "AWS CMK Provider Configuration.",
+			Optional:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"access_key": {
+					Description: "Access Key",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"secret_key": {
+					Description: "Secret Key",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"arn_list": {
+					Description: "AWS ARN List",
+					Type:        types.ListType{ElemType: types.StringType},
+					Required:    true,
+				},
+			}),
+		},
+		"gcp_cmk_spec": {
+			Description: "GCP CMK Provider Configuration.",
+			Optional:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"key_ring_name": {
+					Description: "Key Ring Name",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"key_name": {
+					Description: "Key Name",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"location": {
+					Description: "Location",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"protection_level": {
+					Description: "Key Protection Level",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"gcp_service_account": {
+					Description: "GCP Service Account",
+					Required:    true,
+					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+						"type": {
+							Description: "Service Account Type",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"project_id": {
+							Description: "GCP Project ID",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"private_key": {
+							Description: "Private Key",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"private_key_id": {
+							Description: "Private Key ID",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"client_email": {
+							Description: "Client Email",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"client_id": {
+							Description: "Client ID",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"auth_uri": {
+							Description: "Auth URI",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"token_uri": {
+							Description: "Token URI",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"auth_provider_x509_cert_url": {
+							Description: "Auth Provider X509 Cert URL",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"client_x509_cert_url": {
+							Description: "Client X509 Cert URL",
+							Type:        types.StringType,
+							Required:    true,
+						},
+						"universe_domain": {
+							Description: "Google Universe Domain",
+							Type:        types.StringType,
+							Optional:    true,
+						},
+					}),
+				},
+			}),
+		},
+		"azure_cmk_spec": {
+			Description: "AZURE CMK Provider Configuration.",
+			Optional:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"client_id": {
+					Description: "Azure Active Directory (AD) Client ID for Key Vault service principal.",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"client_secret": {
+					Description: "Azure AD Client Secret for Key Vault service principal.",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"tenant_id": {
+					Description: "Azure AD Tenant ID for Key Vault service principal.",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"key_vault_uri": {
+					Description: "URI of Azure Key Vault storing cryptographic keys.",
+					Type:        types.StringType,
+					Required:    true,
+				},
+				"key_name": {
+					Description: "Name of cryptographic key in Azure Key Vault.",
+					Type:        types.StringType,
+					Required:    true,
+				},
+			}),
+		},
+	}
+}
+
+func (r resourceClusterCMKType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	attributes := map[string]tfsdk.Attribute{
+		"account_id": {
+			Description: "The ID of the account this cluster belongs to.",
+			Type:        types.StringType,
+			Computed:    true,
+			PlanModifiers: []tfsdk.AttributePlanModifier{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"project_id": {
+			Description: "The ID of the project this cluster belongs to.",
+			Type:        types.StringType,
+			Computed:    true,
+			PlanModifiers: []tfsdk.AttributePlanModifier{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": {
+			Description: "The ID of the cluster.",
+			Type:        types.StringType,
+			Required:    true,
+			PlanModifiers: []tfsdk.AttributePlanModifier{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"rotation_trigger": {
+			Description: "Any value. Changing it rotates the key even though the key configuration did not change, " +
+				"for example after a new primary key version was created in the KMS.",
+			Type:     types.StringType,
+			Optional: true,
+		},
+		"status": {
+			Description: "The status of the customer managed key of the cluster.",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"active_key": {
+			Description: "The key configured for the cluster as reported by the API: the comma separated key ARNs for AWS, " +
+				"the key resource name in the project of the service account for GCP and the key identifier for AZURE. " +
+				"The API does not report which of the AWS ARNs or which key version is in use.",
+			Type:     types.StringType,
+			Computed: true,
+		},
+		"last_rotated_on": {
+			Description: "The time of the last change of the customer managed key of the cluster. " +
+				"The API does not report earlier rotations, so no rotation history is available.",
+			Type:     types.StringType,
+			Computed: true,
+		},
+	}
+	for name, attribute := range cmkSpecSchemaAttributes() {
+		attributes[name] = attribute
+	}
+
+	return tfsdk.Schema{
+		Description: `The resource to manage the customer managed key (CMK) used to encrypt a cluster at rest in YugabyteDB Aeon.
+Changing the key configuration or rotation_trigger rotates the key and waits for the cluster to be re-encrypted.
+The key rotation history is not available, only the time of the last rotation is reported in last_rotated_on.
+Do not use this resource together with the deprecated cmk_spec block of the ybm_cluster resource for the same cluster.`,
+		Attributes: attributes,
+	}, nil
+}
+
+func (r resourceClusterCMKType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceClusterCMK{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceClusterCMK struct {
+	p provider
+}
+
+var _ tfsdk.ResourceWithValidateConfig = resourceClusterCMK{}
+
+func (r resourceClusterCMK) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	resp.Diagnostics.Append(validateCMKSpecConfig(ctx, req.Config, path.Empty())...)
+}
+
+// validateCMKSpecConfig validates the customer managed key configuration found at specPath in the
+// config, so that an invalid ARN, key vault URI or service account is reported by terraform plan
+// instead of by the API. Unknown values are skipped.
+func validateCMKSpecConfig(ctx context.Context, config tfsdk.Config, specPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var providerType types.String
+	var awsObject, gcpObject, azureObject types.Object
+	diags.Append(config.GetAttribute(ctx, specPath.AtName("provider_type"), &providerType)...)
+	diags.Append(config.GetAttribute(ctx, specPath.AtName("aws_cmk_spec"), &awsObject)...)
+	diags.Append(config.GetAttribute(ctx, specPath.AtName("gcp_cmk_spec"), &gcpObject)...)
+	diags.Append(config.GetAttribute(ctx, specPath.AtName("azure_cmk_spec"), &azureObject)...)
+	if diags.HasError() || providerType.IsNull() || providerType.IsUnknown() {
+		return diags
+	}
+
+	specs := map[string]types.Object{"AWS": awsObject, "GCP": gcpObject, "AZURE": azureObject}
+	for providerName, specObject := range specs {
+		specName := strings.ToLower(providerName) + "_cmk_spec"
+		if providerName == providerType.Value && specObject.IsNull() {
+			diags.AddAttributeError(specPath.AtName(specName), "Missing CMK provider configuration",
+				fmt.Sprintf("%s must be set when provider_type is %s.", specName, providerName))
+		}
+		if providerName != providerType.Value && !specObject.IsNull() {
+			diags.AddAttributeError(specPath.AtName(specName), "Unexpected CMK provider configuration",
+				fmt.Sprintf("%s can only be set when provider_type is %s.", specName, providerName))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	specObject := specs[providerType.Value]
+	if specObject.IsUnknown() {
+		return diags
+	}
+	asOptions := types.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
+	switch providerType.Value {
+	case "AWS":
+		var awsSpec AWSCMKSpec
+		diags.Append(specObject.As(ctx, &awsSpec, asOptions)...)
+		if diags.HasError() {
+			return diags
+		}
+		arnListPath := specPath.AtName("aws_cmk_spec").AtName("arn_list")
+		var arnList types.List
+		diags.Append(config.GetAttribute(ctx, arnListPath, &arnList)...)
+		if !arnList.IsUnknown() && len(awsSpec.ARNList) == 0 {
+			diags.AddAttributeError(arnListPath, "Invalid AWS CMK configuration", "arn_list must contain at least one key ARN.")
+		}
+		for i, arn := range awsSpec.ARNList {
+			if !arn.IsUnknown() && !arn.IsNull() && !util.IsAWSKMSKeyARNValid(arn.Value) {
+				diags.AddAttributeError(arnListPath.AtListIndex(i), "Invalid AWS CMK configuration",
+					fmt.Sprintf("%v is not the ARN of an AWS KMS key, for example arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab.", arn.Value))
+			}
+		}
+	case "GCP":
+		var gcpSpec GCPCMKSpec
+		diags.Append(specObject.As(ctx, &gcpSpec, asOptions)...)
+		if diags.HasError() {
+			return diags
+		}
+		serviceAccountPath := specPath.AtName("gcp_cmk_spec").AtName("gcp_service_account")
+		serviceAccount := gcpSpec.GcpServiceAccount
+		if isKnownString(serviceAccount.Type) && serviceAccount.Type.Value != "service_account" {
+			diags.AddAttributeError(serviceAccountPath.AtName("type"), "Invalid GCP CMK configuration",
+				fmt.Sprintf("The type of the service account key must be service_account, got %v.", serviceAccount.Type.Value))
+		}
+		if isKnownString(serviceAccount.ClientEmail) && !strings.Contains(serviceAccount.ClientEmail.Value, "@") {
+			diags.AddAttributeError(serviceAccountPath.AtName("client_email"), "Invalid GCP CMK configuration",
+				fmt.Sprintf("%v is not the email address of a service account.", serviceAccount.ClientEmail.Value))
+		}
+		if isKnownString(serviceAccount.PrivateKey) && !strings.Contains(serviceAccount.PrivateKey.Value, "PRIVATE KEY") {
+			diags.AddAttributeError(serviceAccountPath.AtName("private_key"), "Invalid GCP CMK configuration",
+				"The private key of the service account must be PEM encoded, as in the private_key field of the service account key file.")
+		}
+	case "AZURE":
+		var azureSpec AzureCMKSpec
+		diags.Append(specObject.As(ctx, &azureSpec, asOptions)...)
+		if diags.HasError() {
+			return diags
+		}
+		azurePath := specPath.AtName("azure_cmk_spec")
+		if isKnownString(azureSpec.ClientID) && !util.IsUUIDValid(azureSpec.ClientID.Value) {
+			diags.AddAttributeError(azurePath.AtName("client_id"), "Invalid AZURE CMK configuration",
+				fmt.Sprintf("%v is not a valid client ID, it must be a UUID.", azureSpec.ClientID.Value))
+		}
+		if isKnownString(azureSpec.TenantID) && !util.IsUUIDValid(azureSpec.TenantID.Value) {
+			diags.AddAttributeError(azurePath.AtName("tenant_id"), "Invalid AZURE CMK configuration",
+				fmt.Sprintf("%v is not a valid tenant ID, it must be a UUID.", azureSpec.TenantID.Value))
+		}
+		if isKnownString(azureSpec.KeyVaultUri) && !util.IsAzureKeyVaultURIValid(azureSpec.KeyVaultUri.Value) {
+			diags.AddAttributeError(azurePath.AtName("key_vault_uri"), "Invalid AZURE CMK configuration",
+				fmt.Sprintf("%v is not the URI of an Azure Key Vault, for example https://my-vault.vault.azure.net/.", azureSpec.KeyVaultUri.Value))
+		}
+	}
+	return diags
+}
+
+func isKnownString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func getClusterCMKPlan(ctx context.Context, plan tfsdk.Plan, cmk *ClusterCMK) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(plan.GetAttribute(ctx, path.Root("cluster_id"), &cmk.ClusterID)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("provider_type"), &cmk.ProviderType)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("is_enabled"), &cmk.IsEnabled)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("aws_cmk_spec"), &cmk.AWSCMKSpec)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("gcp_cmk_spec"), &cmk.GCPCMKSpec)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("azure_cmk_spec"), &cmk.AzureCMKSpec)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("rotation_trigger"), &cmk.RotationTrigger)...)
+	return diags
+}
+
+func (cmk ClusterCMK) spec() *CMKSpec {
+	return &CMKSpec{
+		ProviderType: cmk.ProviderType,
+		IsEnabled:    cmk.IsEnabled,
+		AWSCMKSpec:   cmk.AWSCMKSpec,
+		GCPCMKSpec:   cmk.GCPCMKSpec,
+		AzureCMKSpec: cmk.AzureCMKSpec,
+	}
+}
+
+// cmkActiveKey identifies the key of a customer managed key configuration. The API does not
+// report the key in use, so for AWS this is the whole configured ARN list.
+func cmkActiveKey(spec *CMKSpec) string {
+	switch spec.ProviderType.Value {
+	case "AWS":
+		if spec.AWSCMKSpec != nil {
+			return strings.Join(util.SliceTypesStringToSliceString(spec.AWSCMKSpec.ARNList), ",")
+		}
+	case "GCP":
+		if spec.GCPCMKSpec != nil {
+			return util.GCPKMSKeyName(spec.GCPCMKSpec.GcpServiceAccount.ProjectId.Value, spec.GCPCMKSpec.Location.Value,
+				spec.GCPCMKSpec.KeyRingName.Value, spec.GCPCMKSpec.KeyName.Value)
+		}
+	case "AZURE":
+		if spec.AzureCMKSpec != nil {
+			return util.AzureKeyVaultKeyID(spec.AzureCMKSpec.KeyVaultUri.Value, spec.AzureCMKSpec.KeyName.Value)
+		}
+	}
+	return ""
+}
+
+// preserveCMKCredentials copies the credentials the API masks from the prior configuration
+func preserveCMKCredentials(spec *CMKSpec, prior *CMKSpec) {
+	switch {
+	case spec.AWSCMKSpec != nil && prior.AWSCMKSpec != nil:
+		spec.AWSCMKSpec.AccessKey = prior.AWSCMKSpec.AccessKey
+		spec.AWSCMKSpec.SecretKey = prior.AWSCMKSpec.SecretKey
+	case spec.GCPCMKSpec != nil && prior.GCPCMKSpec != nil:
+		spec.GCPCMKSpec.GcpServiceAccount.ClientId = prior.GCPCMKSpec.GcpServiceAccount.ClientId
+		spec.GCPCMKSpec.GcpServiceAccount.PrivateKey = prior.GCPCMKSpec.GcpServiceAccount.PrivateKey
+	case spec.AzureCMKSpec != nil && prior.AzureCMKSpec != nil:
+		spec.AzureCMKSpec.ClientSecret = prior.AzureCMKSpec.ClientSecret
+	}
+}
+
+// resourceClusterCMKRead reads the customer managed key of the cluster on top of prior, whose
+// credentials and rotation trigger are kept. found is false if the cluster has no customer
+// managed key or does not exist anymore.
+func resourceClusterCMKRead(ctx context.Context, accountId string, projectId string, prior ClusterCMK, apiClient *openapiclient.APIClient) (cmk ClusterCMK, found bool, readOK bool, errorMessage string) {
+	cmkResp, response, err := apiClient.ClusterApi.GetClusterCMK(ctx, accountId, projectId, prior.ClusterID.Value).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return cmk, false, true, ""
+		}
+		return cmk, false, false, getErrorMessage(response, err)
+	}
+	if cmkResp.Data == nil {
+		return cmk, false, true, ""
+	}
+	cmkData := cmkResp.GetData()
+	spec := flattenCMKSpec(cmkData.Spec.Get())
+	if spec == nil {
+		return cmk, false, true, ""
+	}
+	preserveCMKCredentials(spec, prior.spec())
+
+	cmkInfo := cmkData.GetInfo()
+	cmkMetadata := cmkInfo.GetMetadata()
+	lastRotatedOn := cmkMetadata.GetUpdatedOn()
+	activeKey := cmkActiveKey(spec)
+
+	cmk = ClusterCMK{
+		AccountID:       types.String{Value: accountId},
+		ProjectID:       types.String{Value: projectId},
+		ClusterID:       prior.ClusterID,
+		ProviderType:    spec.ProviderType,
+		IsEnabled:       spec.IsEnabled,
+		AWSCMKSpec:      spec.AWSCMKSpec,
+		GCPCMKSpec:      spec.GCPCMKSpec,
+		AzureCMKSpec:    spec.AzureCMKSpec,
+		RotationTrigger: prior.RotationTrigger,
+		Status:          types.String{Value: string(cmkInfo.GetStatus())},
+		ActiveKey:       types.String{Value: activeKey},
+		LastRotatedOn:   types.String{Value: lastRotatedOn},
+	}
+	return cmk, true, true, ""
+}
+
+// applyClusterCMK sends the customer managed key configuration of the plan to the cluster and
+// waits for the cluster to be re-encrypted with it.
+func (r resourceClusterCMK) applyClusterCMK(ctx context.Context, plan ClusterCMK, prior ClusterCMK, diags *diag.Diagnostics) (ClusterCMK, bool) {
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		diags.AddError("Unable to get account ID", message)
+		return plan, false
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		diags.AddError("Unable to get project ID", message)
+		return plan, false
+	}
+
+	cmkSpec, err := createCmkSpec(plan.spec())
+	if err != nil {
+		diags.AddError("Error creating CMK Spec", err.Error())
+		return plan, false
+	}
+	clusterId := plan.ClusterID.Value
+	tflog.Info(ctx, fmt.Sprintf("Rotating the customer managed key of cluster %v to %v", clusterId, cmkActiveKey(plan.spec())))
+	err = editClusterCmk(ctx, apiClient, accountId, projectId, clusterId, *cmkSpec)
+	if err != nil {
+		diags.AddError("Unable to rotate the customer managed key of the cluster", err.Error())
+		return plan, false
+	}
+
+	// The credentials and the rotation trigger come from the plan
+	prior.ClusterID = plan.ClusterID
+	prior.ProviderType = plan.ProviderType
+	prior.IsEnabled = plan.IsEnabled
+	prior.AWSCMKSpec = plan.AWSCMKSpec
+	prior.GCPCMKSpec = plan.GCPCMKSpec
+	prior.AzureCMKSpec = plan.AzureCMKSpec
+	prior.RotationTrigger = plan.RotationTrigger
+	cmk, found, readOK, message := resourceClusterCMKRead(ctx, accountId, projectId, prior, apiClient)
+	if !readOK {
+		diags.AddError("Unable to read the customer managed key of the cluster", message)
+		return plan, false
+	}
+	if !found {
+		diags.AddError("Unable to read the customer managed key of the cluster", "The cluster has no customer managed key after it was set.")
+		return plan, false
+	}
+	return cmk, true
+}
+
+func (r resourceClusterCMK) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider wasn't configured before being applied, likely because it depends on an unknown value from another resource.",
+		)
+		return
+	}
+
+	var plan ClusterCMK
+	resp.Diagnostics.Append(getClusterCMKPlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmk, ok := r.applyClusterCMK(ctx, plan, ClusterCMK{}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &cmk)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterCMK) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state ClusterCMK
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	cmk, found, readOK, message := resourceClusterCMKRead(ctx, accountId, projectId, state, apiClient)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the customer managed key of the cluster", message)
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &cmk)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceClusterCMK) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state ClusterCMK
+	resp.Diagnostics.Append(getClusterCMKPlan(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmk, ok := r.applyClusterCMK(ctx, plan, state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &cmk)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the key from the state. Removing the customer managed key of a cluster
+// requires disabling it first, which is done by setting is_enabled to false.
+func (r resourceClusterCMK) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state ClusterCMK
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.IsEnabled.Value {
+		resp.Diagnostics.AddWarning("Customer managed key still in use",
+			fmt.Sprintf("The cluster %v stays encrypted with its customer managed key. Set is_enabled to false and apply before removing the ybm_cluster_cmk resource to stop using it.", state.ClusterID.Value))
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceClusterCMK) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("cluster_id"), req, resp)
+}
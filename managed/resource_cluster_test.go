@@ -0,0 +1,62 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"testing"
+)
+
+func TestSelectClusterBackupSchedule(t *testing.T) {
+	defaultSchedule := clusterBackupSchedule{id: "default-schedule-id", description: "Default backup schedule"}
+	hourlySchedule := clusterBackupSchedule{id: "hourly-schedule-id", description: "Hourly incremental backups"}
+
+	testCases := []struct {
+		TestName         string
+		Schedules        []clusterBackupSchedule
+		ScheduleID       string
+		ExpectedSchedule clusterBackupSchedule
+		ExpectedErrors   bool
+	}{
+		{
+			TestName:         "Only the default schedule",
+			Schedules:        []clusterBackupSchedule{defaultSchedule},
+			ExpectedSchedule: defaultSchedule,
+		},
+		{
+			TestName:         "Schedule in the state listed after another schedule",
+			Schedules:        []clusterBackupSchedule{hourlySchedule, defaultSchedule},
+			ScheduleID:       "default-schedule-id",
+			ExpectedSchedule: defaultSchedule,
+		},
+		{
+			TestName:       "Several schedules without a schedule in the state",
+			Schedules:      []clusterBackupSchedule{hourlySchedule, defaultSchedule},
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "Schedule in the state deleted",
+			Schedules:      []clusterBackupSchedule{hourlySchedule},
+			ScheduleID:     "default-schedule-id",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "No schedules",
+			Schedules:      nil,
+			ExpectedErrors: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotSchedule, err := selectClusterBackupSchedule(testCase.Schedules, testCase.ScheduleID)
+			if (err != nil) != testCase.ExpectedErrors {
+				t.Errorf("selectClusterBackupSchedule(%v,%v) error = %v; want error %v", testCase.Schedules, testCase.ScheduleID, err, testCase.ExpectedErrors)
+			}
+			if gotSchedule != testCase.ExpectedSchedule {
+				t.Errorf("selectClusterBackupSchedule(%v,%v) = %v; want %v", testCase.Schedules, testCase.ScheduleID, gotSchedule, testCase.ExpectedSchedule)
+			}
+		})
+	}
+}
@@ -161,7 +161,7 @@ type resourceReadReplicas struct {
 	p provider
 }
 
-func createReadReplicasSpec(ctx context.Context, apiClient *openapiclient.APIClient, accountId string, projectId string, plan ReadReplicas) (readReplicasSpec []openapiclient.ReadReplicaSpec, readReplicaSpecOK bool, errorMessage string) {
+func createReadReplicasSpec(ctx context.Context, apiClient *openapiclient.APIClient, catalog *nodeConfigCatalog, accountId string, projectId string, plan ReadReplicas) (readReplicasSpec []openapiclient.ReadReplicaSpec, readReplicaSpecOK bool, errorMessage string) {
 
 	readReplicasInfo := plan.ReadReplicasInfo
 	// Default tier "PAID" used for read replica. Tier is used to get memory from cpu cores using instance types.
@@ -172,7 +172,7 @@ func createReadReplicasSpec(ctx context.Context, apiClient *openapiclient.APICli
 		cloud := readReplica.CloudType.Value
 		region := readReplica.Region.Value
 		numCores := int32(readReplica.NodeConfig.NumCores.Value)
-		memoryMb, memoryOK, message := getMemoryFromInstanceType(ctx, apiClient, accountId, cloud, tier, region, numCores)
+		memoryMb, memoryOK, message := getMemoryFromInstanceType(ctx, apiClient, catalog, accountId, cloud, tier, region, numCores)
 		if !memoryOK {
 			return nil, false, message
 		}
@@ -281,7 +281,7 @@ func (r resourceReadReplicas) Create(ctx context.Context, req tfsdk.CreateResour
 
 	clusterId := plan.PrimaryClusterID.Value
 
-	readReplicasSpec, readReplicasOK, message := createReadReplicasSpec(ctx, apiClient, accountId, projectId, plan)
+	readReplicasSpec, readReplicasOK, message := createReadReplicasSpec(ctx, apiClient, r.p.nodeConfigCatalog, accountId, projectId, plan)
 	if !readReplicasOK {
 		resp.Diagnostics.AddError("Unable to create read replicas spec", message)
 		return
@@ -469,7 +469,7 @@ func (r resourceReadReplicas) Update(ctx context.Context, req tfsdk.UpdateResour
 
 	apiClient := r.p.client
 
-	readReplicasSpec, readReplicasOK, message := createReadReplicasSpec(ctx, apiClient, accountId, projectId, plan)
+	readReplicasSpec, readReplicasOK, message := createReadReplicasSpec(ctx, apiClient, r.p.nodeConfigCatalog, accountId, projectId, plan)
 	if !readReplicasOK {
 		resp.Diagnostics.AddError("Unable to create read replicas spec", message)
 		return
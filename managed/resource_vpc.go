@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	retry "github.com/sethvargo/go-retry"
+	"github.com/yugabyte/terraform-provider-ybm/managed/validation"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -93,6 +94,73 @@ type resourceVPC struct {
 	p provider
 }
 
+var _ tfsdk.ResourceWithValidateConfig = resourceVPC{}
+
+// ValidateConfig checks the CIDRs of the VPC, so that a malformed, undersized or overlapping CIDR is
+// reported by terraform plan instead of by the API. Unknown values are skipped.
+func (r resourceVPC) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var cloud, globalCIDR types.String
+	var regionCIDRInfoList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloud"), &cloud)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("global_cidr"), &globalCIDR)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region_cidr_info"), &regionCIDRInfoList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The size limits depend on the cloud, they are only checked once it is known
+	cloudName := ""
+	if !cloud.IsNull() && !cloud.IsUnknown() {
+		cloudName = cloud.Value
+	}
+	if !globalCIDR.IsNull() && !globalCIDR.IsUnknown() {
+		if err := validation.ValidateVPCCIDR(cloudName, globalCIDR.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("global_cidr"), "Invalid global CIDR", err.Error())
+		}
+	}
+	if regionCIDRInfoList.IsNull() || regionCIDRInfoList.IsUnknown() {
+		return
+	}
+
+	var validRegionCIDRs []VPCRegionInfo
+	var validRegionIndexes []int
+	asOptions := types.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
+	for index, element := range regionCIDRInfoList.Elems {
+		regionObject, ok := element.(types.Object)
+		if !ok || regionObject.IsNull() || regionObject.IsUnknown() {
+			continue
+		}
+		var regionInfo VPCRegionInfo
+		resp.Diagnostics.Append(regionObject.As(ctx, &regionInfo, asOptions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if regionInfo.CIDR.IsNull() || regionInfo.CIDR.IsUnknown() {
+			continue
+		}
+		if err := validation.ValidateVPCCIDR(cloudName, regionInfo.CIDR.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("region_cidr_info").AtListIndex(index).AtName("cidr"), "Invalid region CIDR", err.Error())
+			continue
+		}
+		validRegionCIDRs = append(validRegionCIDRs, regionInfo)
+		validRegionIndexes = append(validRegionIndexes, index)
+	}
+
+	// The regions of a VPC are routed to each other, their ranges must be disjoint
+	for i := range validRegionCIDRs {
+		for j := i + 1; j < len(validRegionCIDRs); j++ {
+			if validation.CIDRsOverlap(validRegionCIDRs[i].CIDR.Value, validRegionCIDRs[j].CIDR.Value) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("region_cidr_info").AtListIndex(validRegionIndexes[j]).AtName("cidr"),
+					"Overlapping region CIDRs",
+					fmt.Sprintf("The CIDR %v of region %v overlaps the CIDR %v of region %v.",
+						validRegionCIDRs[j].CIDR.Value, validRegionCIDRs[j].Region.Value, validRegionCIDRs[i].CIDR.Value, validRegionCIDRs[i].Region.Value),
+				)
+			}
+		}
+	}
+}
+
 func getVPCPlan(ctx context.Context, plan tfsdk.Plan, vpc *VPC) diag.Diagnostics {
 	// NOTE: currently must manually fill out each attribute due to usage of Go structs
 	// Once the opt-in conversion of null or unknown values to the empty value is implemented, this can all be replaced with req.Plan.Get(ctx, &vpc)
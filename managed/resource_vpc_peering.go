@@ -7,15 +7,19 @@ package managed
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	//"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	retry "github.com/sethvargo/go-retry"
+	"github.com/yugabyte/terraform-provider-ybm/managed/util"
+	"github.com/yugabyte/terraform-provider-ybm/managed/validation"
 	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
 )
 
@@ -23,22 +27,33 @@ type resourceVPCPeeringType struct{}
 
 func (r resourceVPCPeeringType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		Description: `The resource to create a VPC peering in YugabyteDB Aeon.`,
+		Description: `The resource to create a VPC peering in YugabyteDB Aeon. The peering stays PENDING until it is accepted on the application side,
+using peering_connection_id, and becomes ACTIVE once accepted. On Azure, the peering is accepted by peering the application virtual network
+with the YugabyteDB virtual network yugabytedb_external_vpc_id. The application VPC must then route application_route_cidrs through the peering.`,
 		Attributes: map[string]tfsdk.Attribute{
 			"account_id": {
 				Description: "The ID of the account this VPC peering belongs to.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"project_id": {
 				Description: "The ID of the project this VPC peering belongs to.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"vpc_peering_id": {
 				Description: "The ID of the VPC peering.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"name": {
 				Description:   "The name of the VPC peering.",
@@ -99,6 +114,47 @@ func (r resourceVPCPeeringType) GetSchema(_ context.Context) (tfsdk.Schema, diag
 				Description: "The state of the VPC peering.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"peering_connection_id": {
+				Description: "The ID of the peering connection on the YugabyteDB side, which the application side must accept: the pcx- ID of the peering connection for AWS, the name of the peering of the YugabyteDB VPC network for GCP, the name of the peering of the YugabyteDB virtual network for Azure.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"application_route_cidrs": {
+				Description: "The CIDRs of the YugabyteDB VPC. The route tables of the application VPC must route them through the peering connection. Null when the YugabyteDB VPC cannot be read.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"yugabytedb_external_vpc_id": {
+				Description: "The ID of the YugabyteDB VPC on the cloud provider. On Azure, the peering is accepted by peering the application virtual network with this virtual network, in the other direction. Null when the YugabyteDB VPC cannot be read.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"wait_for_active": {
+				Description: `Set to true to wait, when the VPC peering is created, until it is accepted on the application side and ACTIVE. Defaults to false.
+Do not set it when the peering is accepted by a resource depending on this one in the same configuration, as the wait would never end.`,
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"wait_for_active_timeout_in_mins": {
+				Description: "How long to wait for the VPC peering to become ACTIVE when wait_for_active is set. Defaults to 60 minutes.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Validators:  []tfsdk.AttributeValidator{int64validator.AtLeast(1)},
 			},
 		},
 	}, nil
@@ -114,6 +170,74 @@ type resourceVPCPeering struct {
 	p provider
 }
 
+var _ tfsdk.ResourceWithValidateConfig = resourceVPCPeering{}
+
+func (r resourceVPCPeering) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	cidrPath := path.Root("application_vpc_info").AtName("cidr")
+	var cidr types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, cidrPath, &cidr)...)
+	if resp.Diagnostics.HasError() || cidr.IsNull() || cidr.IsUnknown() {
+		return
+	}
+	if err := validation.ValidateNetworkCIDR(cidr.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(cidrPath, "Invalid application VPC CIDR", err.Error())
+	}
+}
+
+var _ tfsdk.ResourceWithModifyPlan = resourceVPCPeering{}
+
+// ModifyPlan checks the application CIDR against the CIDRs of the YugabyteDB VPC, as the peering of
+// overlapping ranges is accepted but breaks the routing between the VPCs.
+func (r resourceVPCPeering) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.p.configured {
+		return
+	}
+
+	cidrPath := path.Root("application_vpc_info").AtName("cidr")
+	var vpcId, cidr types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("yugabytedb_vpc_id"), &vpcId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, cidrPath, &cidr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The VPC may not exist yet, e.g. when it is created in the same apply
+	if vpcId.IsNull() || vpcId.IsUnknown() || cidr.IsNull() || cidr.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateVPCId, stateCIDR types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("yugabytedb_vpc_id"), &stateVPCId)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, cidrPath, &stateCIDR)...)
+		if resp.Diagnostics.HasError() || (stateVPCId.Value == vpcId.Value && stateCIDR.Value == cidr.Value) {
+			return
+		}
+	}
+
+	apiClient := r.p.client
+	accountId, getAccountOK, message := getAccountId(ctx, apiClient)
+	if !getAccountOK {
+		resp.Diagnostics.AddError("Unable to get account ID", message)
+		return
+	}
+	projectId, getProjectOK, message := getProjectId(ctx, apiClient, accountId)
+	if !getProjectOK {
+		resp.Diagnostics.AddError("Unable to get project ID", message)
+		return
+	}
+
+	vpcData, err := getVPCByID(ctx, accountId, projectId, vpcId.Value, apiClient)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("yugabytedb_vpc_id"), "Unable to read the VPC", err.Error())
+		return
+	}
+	for _, vpcCIDR := range getVPCCIDRs(vpcData) {
+		if validation.CIDRsOverlap(cidr.Value, vpcCIDR) {
+			resp.Diagnostics.AddAttributeError(cidrPath, "Overlapping application VPC CIDR",
+				fmt.Sprintf("The CIDR %v of the application VPC overlaps the CIDR %v of the YugabyteDB VPC %v.", cidr.Value, vpcCIDR, vpcId.Value))
+		}
+	}
+}
+
 func getVPCPeeringPlan(ctx context.Context, plan tfsdk.Plan, vpcPeering *VPCPeering) diag.Diagnostics {
 	// NOTE: currently must manually fill out each attribute due to usage of Go structs
 	// Once the opt-in conversion of null or unknown values to the empty value is implemented, this can all be replaced with req.Plan.Get(ctx, &vpc)
@@ -125,6 +249,8 @@ func getVPCPeeringPlan(ctx context.Context, plan tfsdk.Plan, vpcPeering *VPCPeer
 	diags.Append(plan.GetAttribute(ctx, path.Root("yugabytedb_vpc_id"), &vpcPeering.YugabyteDBVPCID)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("name"), &vpcPeering.Name)...)
 	diags.Append(plan.GetAttribute(ctx, path.Root("application_vpc_info"), &vpcPeering.ApplicationVPCInfo)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("wait_for_active"), &vpcPeering.WaitForActive)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("wait_for_active_timeout_in_mins"), &vpcPeering.WaitForActiveTimeoutInMins)...)
 
 	return diags
 }
@@ -143,8 +269,6 @@ func (r resourceVPCPeering) Create(ctx context.Context, req tfsdk.CreateResource
 	var plan VPCPeering
 	var accountId, message string
 	var getAccountOK bool
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(getVPCPeeringPlan(ctx, req.Plan, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -261,27 +385,77 @@ func (r resourceVPCPeering) Create(ctx context.Context, req tfsdk.CreateResource
 		return
 	}
 
-	vpcPeering, readOK, message := resourceVPCPeeringRead(accountId, projectId, vpcPeeringId, apiClient)
+	vpcPeering, readOK, message := resourceVPCPeeringRead(ctx, accountId, projectId, vpcPeeringId, apiClient)
 	if !readOK {
 		resp.Diagnostics.AddError("Unable to read the state of the VPC peering", message)
 		return
 	}
+	vpcPeering.WaitForActive = plan.WaitForActive
+	vpcPeering.WaitForActiveTimeoutInMins = plan.WaitForActiveTimeoutInMins
 
-	diags = resp.State.Set(ctx, &vpcPeering)
+	diags := resp.State.Set(ctx, &vpcPeering)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if plan.WaitForActive.Value && vpcPeering.VPCPeeringState.Value != "ACTIVE" {
+		// The peering exists whether or not it gets accepted, so it is kept in the state and tainted on timeout
+		timeoutInMins := int64(60)
+		if !plan.WaitForActiveTimeoutInMins.IsNull() {
+			timeoutInMins = plan.WaitForActiveTimeoutInMins.Value
+		}
+		tflog.Info(ctx, fmt.Sprintf("Waiting up to %d minutes for VPC peering %s to be accepted", timeoutInMins, vpcPeeringId))
+		err = waitForVPCPeeringActive(ctx, accountId, projectId, vpcPeeringId, time.Duration(timeoutInMins)*time.Minute, apiClient)
+		if err != nil {
+			resp.Diagnostics.AddError("VPC peering not accepted", err.Error())
+		}
+
+		vpcPeering, readOK, message = resourceVPCPeeringRead(ctx, accountId, projectId, vpcPeeringId, apiClient)
+		if !readOK {
+			resp.Diagnostics.AddError("Unable to read the state of the VPC peering", message)
+			return
+		}
+		vpcPeering.WaitForActive = plan.WaitForActive
+		vpcPeering.WaitForActiveTimeoutInMins = plan.WaitForActiveTimeoutInMins
+		resp.Diagnostics.Append(resp.State.Set(ctx, &vpcPeering)...)
+	}
+}
+
+func waitForVPCPeeringActive(ctx context.Context, accountId string, projectId string, vpcPeeringId string, timeout time.Duration, apiClient *openapiclient.APIClient) error {
+	retryPolicy := retry.NewConstant(10 * time.Second)
+	retryPolicy = retry.WithMaxDuration(timeout, retryPolicy)
+	state := ""
+	err := retry.Do(ctx, retryPolicy, func(ctx context.Context) error {
+		vpcPeeringResp, _, err := apiClient.NetworkApi.GetVpcPeering(ctx, accountId, projectId, vpcPeeringId).Execute()
+		if err != nil {
+			return retry.RetryableError(errors.New("unable to read the VPC peering: " + GetApiErrorDetails(err)))
+		}
+		state = string(vpcPeeringResp.Data.Info.GetState())
+		switch state {
+		case "ACTIVE":
+			return nil
+		case "FAILED":
+			return errors.New("the VPC peering failed")
+		}
+		return retry.RetryableError(errors.New("the VPC peering is waiting to be accepted"))
+	})
+	if err != nil {
+		return fmt.Errorf("the VPC peering %s is %s after waiting for it to become ACTIVE: %s", vpcPeeringId, state, err)
+	}
+	return nil
 }
 
 func getIDsFromVPCPeeringState(ctx context.Context, state tfsdk.State, vpcPeering *VPCPeering) {
 	state.GetAttribute(ctx, path.Root("account_id"), &vpcPeering.AccountID)
 	state.GetAttribute(ctx, path.Root("project_id"), &vpcPeering.ProjectID)
 	state.GetAttribute(ctx, path.Root("vpc_peering_id"), &vpcPeering.VPCPeeringID)
+	state.GetAttribute(ctx, path.Root("wait_for_active"), &vpcPeering.WaitForActive)
+	state.GetAttribute(ctx, path.Root("wait_for_active_timeout_in_mins"), &vpcPeering.WaitForActiveTimeoutInMins)
 }
 
-func resourceVPCPeeringRead(accountId string, projectId string, vpcPeeringId string, apiClient *openapiclient.APIClient) (vpcPeering VPCPeering, readOK bool, errorMessage string) {
-	vpcPeeringResp, response, err := apiClient.NetworkApi.GetVpcPeering(context.Background(), accountId, projectId, vpcPeeringId).Execute()
+func resourceVPCPeeringRead(ctx context.Context, accountId string, projectId string, vpcPeeringId string, apiClient *openapiclient.APIClient) (vpcPeering VPCPeering, readOK bool, errorMessage string) {
+	vpcPeeringResp, response, err := apiClient.NetworkApi.GetVpcPeering(ctx, accountId, projectId, vpcPeeringId).Execute()
 	if err != nil {
 		errMsg := getErrorMessage(response, err)
 		return vpcPeering, false, errMsg
@@ -294,22 +468,57 @@ func resourceVPCPeeringRead(accountId string, projectId string, vpcPeeringId str
 	vpcPeering.Name.Value = vpcPeeringResp.Data.Spec.GetName()
 	vpcPeering.YugabyteDBVPCID.Value = vpcPeeringResp.Data.Spec.GetInternalYugabyteVpcId()
 	vpcPeering.VPCPeeringState.Value = string(vpcPeeringResp.Data.Info.GetState())
-	cloud := string(vpcPeeringResp.Data.Spec.CustomerVpc.CloudInfo.GetCode())
-	vpcPeering.ApplicationVPCInfo.Cloud.Value = cloud
+	vpcPeering.PeeringConnectionID.Value = vpcPeeringResp.Data.Info.GetPeeringConnectionId()
+	vpcPeering.ApplicationVPCInfo = flattenApplicationVPCInfo(vpcPeeringResp.Data.Spec.CustomerVpc)
+
+	// The routing details come from the YugabyteDB VPC. Failing to read it, e.g. after it was deleted, must not
+	// fail the read of the peering, the details are then unknown.
+	vpcData, err := getVPCByID(ctx, accountId, projectId, vpcPeering.YugabyteDBVPCID.Value, apiClient)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read the routing details of VPC peering %s: %s", vpcPeeringId, err))
+		vpcPeering.ApplicationRouteCIDRs = nil
+		vpcPeering.YugabyteDBExternalVPCID.Null = true
+		return vpcPeering, true, ""
+	}
+	vpcPeering.ApplicationRouteCIDRs = util.SliceStringToSliceTypesString(getVPCCIDRs(vpcData))
+	vpcPeering.YugabyteDBExternalVPCID = types.String{Value: vpcData.Info.GetExternalVpcId()}
+
+	return vpcPeering, true, ""
+}
+
+// getVPCCIDRs returns the global CIDR of a VPC, or the CIDRs of its regions.
+func getVPCCIDRs(vpcData openapiclient.SingleTenantVpcDataResponse) []string {
+	vpcCIDRs := []string{}
+	if parentCIDR := vpcData.Spec.GetParentCidr(); parentCIDR != "" {
+		vpcCIDRs = append(vpcCIDRs, parentCIDR)
+	}
+	if regionSpecs, ok := vpcData.Spec.GetRegionSpecsOk(); ok {
+		for _, regionSpec := range *regionSpecs {
+			if regionSpec.GetCidr() != "" {
+				vpcCIDRs = append(vpcCIDRs, regionSpec.GetCidr())
+			}
+		}
+	}
+	return vpcCIDRs
+}
+
+func flattenApplicationVPCInfo(customerVpc openapiclient.CustomerVpcSpec) (applicationVPCInfo ApplicationVPCInfo) {
+	cloud := string(customerVpc.CloudInfo.GetCode())
+	applicationVPCInfo.Cloud.Value = cloud
 	if cloud == "AWS" {
-		vpcPeering.ApplicationVPCInfo.AccountID.Value = vpcPeeringResp.Data.Spec.CustomerVpc.GetCloudProviderProject()
-		vpcPeering.ApplicationVPCInfo.Project.Null = true
-		vpcPeering.ApplicationVPCInfo.Region.Value = vpcPeeringResp.Data.Spec.CustomerVpc.CloudInfo.GetRegion()
+		applicationVPCInfo.AccountID.Value = customerVpc.GetCloudProviderProject()
+		applicationVPCInfo.Project.Null = true
+		applicationVPCInfo.Region.Value = customerVpc.CloudInfo.GetRegion()
 	} else {
-		vpcPeering.ApplicationVPCInfo.Project.Value = vpcPeeringResp.Data.Spec.CustomerVpc.GetCloudProviderProject()
-		vpcPeering.ApplicationVPCInfo.AccountID.Null = true
-		vpcPeering.ApplicationVPCInfo.Region.Null = true
+		applicationVPCInfo.Project.Value = customerVpc.GetCloudProviderProject()
+		applicationVPCInfo.AccountID.Null = true
+		applicationVPCInfo.Region.Null = true
 	}
 
-	vpcPeering.ApplicationVPCInfo.VPCID.Value = vpcPeeringResp.Data.Spec.CustomerVpc.GetExternalVpcId()
-	vpcPeering.ApplicationVPCInfo.CIDR.Value = vpcPeeringResp.Data.Spec.CustomerVpc.GetCidr()
+	applicationVPCInfo.VPCID.Value = customerVpc.GetExternalVpcId()
+	applicationVPCInfo.CIDR.Value = customerVpc.GetCidr()
 
-	return vpcPeering, true, ""
+	return applicationVPCInfo
 }
 
 // Read vpc peering
@@ -317,11 +526,13 @@ func (r resourceVPCPeering) Read(ctx context.Context, req tfsdk.ReadResourceRequ
 	var state VPCPeering
 	getIDsFromVPCPeeringState(ctx, req.State, &state)
 
-	vpc, readOK, message := resourceVPCPeeringRead(state.AccountID.Value, state.ProjectID.Value, state.VPCPeeringID.Value, r.p.client)
+	vpc, readOK, message := resourceVPCPeeringRead(ctx, state.AccountID.Value, state.ProjectID.Value, state.VPCPeeringID.Value, r.p.client)
 	if !readOK {
 		resp.Diagnostics.AddError("Unable to read the state of the VPC peering", message)
 		return
 	}
+	vpc.WaitForActive = state.WaitForActive
+	vpc.WaitForActiveTimeoutInMins = state.WaitForActiveTimeoutInMins
 
 	diags := resp.State.Set(ctx, &vpc)
 	resp.Diagnostics.Append(diags...)
@@ -333,9 +544,26 @@ func (r resourceVPCPeering) Read(ctx context.Context, req tfsdk.ReadResourceRequ
 // Update vpc peering
 func (r resourceVPCPeering) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
 
-	resp.Diagnostics.AddError("Unable to update VPC peering.", "Updating a VPC peering is not currently supported. Delete and recreate the provider.")
-	return
+	// Every attribute of the VPC peering forces a new one, only the wait settings, which only apply
+	// to the creation, can change in place
+	var plan VPCPeering
+	resp.Diagnostics.Append(getVPCPeeringPlan(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state VPCPeering
+	getIDsFromVPCPeeringState(ctx, req.State, &state)
+
+	vpcPeering, readOK, message := resourceVPCPeeringRead(ctx, state.AccountID.Value, state.ProjectID.Value, state.VPCPeeringID.Value, r.p.client)
+	if !readOK {
+		resp.Diagnostics.AddError("Unable to read the state of the VPC peering", message)
+		return
+	}
+	vpcPeering.WaitForActive = plan.WaitForActive
+	vpcPeering.WaitForActiveTimeoutInMins = plan.WaitForActiveTimeoutInMins
 
+	diags := resp.State.Set(ctx, &vpcPeering)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete vpc peering
@@ -0,0 +1,136 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package managed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	mocks "github.com/yugabyte/terraform-provider-ybm/mock_yugabytedb_managed_go_client_internal"
+	openapiclient "github.com/yugabyte/yugabytedb-managed-go-client-internal"
+)
+
+func getGetVPCPeeringRequest(ctx context.Context, cfg *openapiclient.Configuration, accountID string, projectID string, vpcPeeringID string, mockNetworkApi *mocks.MockNetworkApi) *openapiclient.ApiGetVpcPeeringRequest {
+	testClient := openapiclient.NewAPIClient(cfg)
+	getVPCPeeringRequest := testClient.NetworkApi.GetVpcPeering(ctx, accountID, projectID, vpcPeeringID)
+	getVPCPeeringRequest.ApiService = mockNetworkApi
+	return &getVPCPeeringRequest
+}
+
+func getGetSingleTenantVPCRequest(ctx context.Context, cfg *openapiclient.Configuration, accountID string, projectID string, vpcID string, mockNetworkApi *mocks.MockNetworkApi) *openapiclient.ApiGetSingleTenantVpcRequest {
+	testClient := openapiclient.NewAPIClient(cfg)
+	getSingleTenantVPCRequest := testClient.NetworkApi.GetSingleTenantVpc(ctx, accountID, projectID, vpcID)
+	getSingleTenantVPCRequest.ApiService = mockNetworkApi
+	return &getSingleTenantVPCRequest
+}
+
+func getVPCPeeringResponse(t *testing.T, vpcID string, state string) *openapiclient.VpcPeeringResponse {
+	vpcPeeringResponse := openapiclient.NewVpcPeeringResponseWithDefaults()
+	body := `{"data": {"spec": {"name": "app-peering", "internal_yugabyte_vpc_id": "` + vpcID + `"}, "info": {"state": "` + state + `"}}}`
+	if err := json.Unmarshal([]byte(body), vpcPeeringResponse); err != nil {
+		t.Fatalf("Unable to build the VPC peering response: %v", err)
+	}
+	return vpcPeeringResponse
+}
+
+func TestWaitForVPCPeeringActive(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockNetworkApi := mocks.NewMockNetworkApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	vpcPeeringID := "test-vpc-peering-id"
+
+	apiClient := openapiclient.NewAPIClient(cfg)
+	apiClient.NetworkApi = mockNetworkApi
+	getVPCPeeringRequest := getGetVPCPeeringRequest(ctx, cfg, accountID, projectID, vpcPeeringID, mockNetworkApi)
+
+	testCases := []struct {
+		TestName       string
+		State          string
+		Timeout        time.Duration
+		ExpectedErrors bool
+	}{
+		{
+			TestName:       "VPC peering active",
+			State:          "ACTIVE",
+			Timeout:        time.Minute,
+			ExpectedErrors: false,
+		},
+		{
+			TestName:       "VPC peering failed",
+			State:          "FAILED",
+			Timeout:        time.Minute,
+			ExpectedErrors: true,
+		},
+		{
+			// The timeout is shorter than the retry delay, so the wait ends after the first read.
+			TestName:       "VPC peering not accepted before the timeout",
+			State:          "PENDING",
+			Timeout:        time.Millisecond,
+			ExpectedErrors: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			vpcPeeringResponse := getVPCPeeringResponse(t, "test-vpc-id", testCase.State)
+			mockNetworkApi.EXPECT().GetVpcPeering(gomock.Any(), accountID, projectID, vpcPeeringID).Return(*getVPCPeeringRequest).Times(1)
+			mockNetworkApi.EXPECT().GetVpcPeeringExecute(*getVPCPeeringRequest).Return(*vpcPeeringResponse, nil, nil).Times(1)
+
+			err := waitForVPCPeeringActive(ctx, accountID, projectID, vpcPeeringID, testCase.Timeout, apiClient)
+			if (err != nil) != testCase.ExpectedErrors {
+				t.Errorf("Got error: %v, Expected errors: %v", err, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
+
+func TestReadVPCPeeringWithoutVPC(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockNetworkApi := mocks.NewMockNetworkApi(mockCtrl)
+	ctx := context.Background()
+	cfg := openapiclient.NewConfiguration()
+
+	accountID := "test-account-id"
+	projectID := "test-project-id"
+	vpcPeeringID := "test-vpc-peering-id"
+	vpcID := "test-vpc-id"
+
+	apiClient := openapiclient.NewAPIClient(cfg)
+	apiClient.NetworkApi = mockNetworkApi
+	getVPCPeeringRequest := getGetVPCPeeringRequest(ctx, cfg, accountID, projectID, vpcPeeringID, mockNetworkApi)
+	getSingleTenantVPCRequest := getGetSingleTenantVPCRequest(ctx, cfg, accountID, projectID, vpcID, mockNetworkApi)
+	vpcPeeringResponse := getVPCPeeringResponse(t, vpcID, "ACTIVE")
+
+	mockNetworkApi.EXPECT().GetVpcPeering(ctx, accountID, projectID, vpcPeeringID).Return(*getVPCPeeringRequest).Times(1)
+	mockNetworkApi.EXPECT().GetVpcPeeringExecute(*getVPCPeeringRequest).Return(*vpcPeeringResponse, nil, nil).Times(1)
+	mockNetworkApi.EXPECT().GetSingleTenantVpc(ctx, accountID, projectID, vpcID).Return(*getSingleTenantVPCRequest).Times(1)
+	mockNetworkApi.EXPECT().GetSingleTenantVpcExecute(*getSingleTenantVPCRequest).Return(openapiclient.SingleTenantVpcResponse{}, nil, errors.New("internal server error")).Times(1)
+
+	vpcPeering, readOK, message := resourceVPCPeeringRead(ctx, accountID, projectID, vpcPeeringID, apiClient)
+	if !readOK {
+		t.Fatalf("Got read error: %s, Expected the VPC peering to be read", message)
+	}
+	if vpcPeering.VPCPeeringState.Value != "ACTIVE" {
+		t.Errorf("Got state: %s, Expected: ACTIVE", vpcPeering.VPCPeeringState.Value)
+	}
+	if vpcPeering.ApplicationRouteCIDRs != nil {
+		t.Errorf("Got application route CIDRs: %v, Expected them to be unknown", vpcPeering.ApplicationRouteCIDRs)
+	}
+	if !vpcPeering.YugabyteDBExternalVPCID.Null {
+		t.Errorf("Got YugabyteDB external VPC ID: %v, Expected it to be unknown", vpcPeering.YugabyteDBExternalVPCID)
+	}
+}
@@ -5,7 +5,14 @@
 package util
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -22,13 +29,45 @@ func AreListsEqual(l1 []string, l2 []string) bool {
 	return true
 }
 
+// MinPaidDiskSizeGb is the smallest disk the nodes of a PAID cluster can have.
+const MinPaidDiskSizeGb = 50
+
 func IsDiskSizeValid(clusterTier string, diskSize int64) bool {
-	if clusterTier == "PAID" && diskSize < 50 {
+	if clusterTier == "PAID" && diskSize < MinPaidDiskSizeGb {
 		return false
 	}
 	return true
 }
 
+// IsClusterTierChangeValid reports whether a cluster can be moved from one tier to another in
+// place. Sandbox (FREE) clusters can be upgraded to dedicated (PAID) ones, the other way is not
+// supported.
+func IsClusterTierChangeValid(fromTier string, toTier string) (bool, string) {
+	if fromTier == "PAID" && toTier == "FREE" {
+		return false, "A PAID cluster cannot be downgraded to FREE. Create a new FREE cluster instead."
+	}
+	return true, ""
+}
+
+const (
+	MinDiskIops  = 3000
+	MaxDiskIops  = 16000
+	DiskIopsStep = 1000
+)
+
+// DiskIopsRange returns the default disk IOPS limits of the nodes of a cluster, used when the
+// catalogue does not report the limits of a node. Only AWS supports configuring the disk IOPS,
+// and only PAID clusters can go beyond the default.
+func DiskIopsRange(cloudType string, clusterTier string) (minIops int64, maxIops int64, configurable bool) {
+	if cloudType != "AWS" {
+		return 0, 0, false
+	}
+	if clusterTier != "PAID" {
+		return MinDiskIops, MinDiskIops, true
+	}
+	return MinDiskIops, MaxDiskIops, true
+}
+
 func IsDiskIopsValid(cloudType string, clusterTier string, diskIops int64) (bool, string) {
 	err := ""
 	if cloudType != "AWS" {
@@ -40,23 +79,612 @@ func IsDiskIopsValid(cloudType string, clusterTier string, diskIops int64) (bool
 	}
 
 	if clusterTier != "PAID" {
-		if diskIops != 3000 {
+		if diskIops != MinDiskIops {
 			err = "Custom Disk IOPS is only supported for PAID tier"
 			return false, err
 		}
 	} else {
-		if diskIops%1000 != 0 {
-			err = "Disk IOPS must be a multiple of 1000"
+		if diskIops%DiskIopsStep != 0 {
+			err = fmt.Sprintf("Disk IOPS must be a multiple of %d", DiskIopsStep)
 			return false, err
 		}
-		if diskIops < 3000 || diskIops > 16000 {
-			err = "Disk IOPS must be between 3000 and 16000 (inclusive)"
+		if diskIops < MinDiskIops || diskIops > MaxDiskIops {
+			err = fmt.Sprintf("Disk IOPS must be between %d and %d (inclusive)", MinDiskIops, MaxDiskIops)
 			return false, err
 		}
 	}
 	return true, err
 }
 
+// IsTaskRunning reports whether a task in the given state has not completed yet.
+func IsTaskRunning(state string) bool {
+	switch strings.ToUpper(state) {
+	case "SUCCEEDED", "FAILED", "CANCELLED", "CANCELED":
+		return false
+	}
+	return state != ""
+}
+
+// BackupType classifies a backup as SCHEDULED, ON_DEMAND or INCREMENTAL from the action that
+// created it and whether it only holds the changes since the previous backup.
+func BackupType(actionType string, incremental bool) string {
+	if incremental {
+		return "INCREMENTAL"
+	}
+	if strings.Contains(strings.ToUpper(actionType), "SCHEDULE") {
+		return "SCHEDULED"
+	}
+	return "ON_DEMAND"
+}
+
+// DurationSeconds returns the number of seconds between two RFC 3339 times. ok is false when
+// either time is missing or not in RFC 3339 format, e.g. for an operation still running.
+func DurationSeconds(start string, end string) (seconds int64, ok bool) {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return 0, false
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return 0, false
+	}
+	return int64(endTime.Sub(startTime).Seconds()), true
+}
+
+// databaseTrackAliases maps the deprecated database track names to the track they now refer to.
+var databaseTrackAliases = map[string]string{
+	"Production":   "Extended",
+	"Innovation":   "Extended",
+	"Preview":      "Rapid",
+	"Early Access": "Rapid",
+}
+
+// CanonicalTrackName returns the name of the database track a possibly deprecated track name
+// refers to. Names that are not aliases are returned unchanged.
+func CanonicalTrackName(trackName string) string {
+	if canonicalName, ok := databaseTrackAliases[trackName]; ok {
+		return canonicalName
+	}
+	return trackName
+}
+
+// TrackAliases returns the deprecated names that are accepted for a database track, sorted.
+func TrackAliases(trackName string) []string {
+	aliases := []string{}
+	for alias, canonicalName := range databaseTrackAliases {
+		if canonicalName == trackName {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// CompareDatabaseVersions compares two database versions such as 2.20.1.0-b97 component by
+// component, the build number included. It returns a negative number when v1 is older than v2,
+// zero when they are the same and a positive number when v1 is newer.
+func CompareDatabaseVersions(v1 string, v2 string) int {
+	c1, c2 := databaseVersionComponents(v1), databaseVersionComponents(v2)
+	for i := 0; i < len(c1) || i < len(c2); i++ {
+		var n1, n2 int64
+		if i < len(c1) {
+			n1 = c1[i]
+		}
+		if i < len(c2) {
+			n2 = c2[i]
+		}
+		if n1 != n2 {
+			if n1 < n2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func databaseVersionComponents(version string) []int64 {
+	components := []int64{}
+	for _, field := range strings.FieldsFunc(version, func(r rune) bool { return r < '0' || r > '9' }) {
+		n, _ := strconv.ParseInt(field, 10, 64)
+		components = append(components, n)
+	}
+	return components
+}
+
+// ReplicationFactor returns the number of copies of the data a cluster keeps for the given fault
+// tolerance. Clusters that tolerate faults keep 2f+1 copies and tolerate a single fault by default.
+func ReplicationFactor(faultTolerance string, numFaultsToTolerate int64) int64 {
+	if faultTolerance == "NONE" {
+		return 1
+	}
+	if numFaultsToTolerate < 1 {
+		numFaultsToTolerate = 1
+	}
+	return 2*numFaultsToTolerate + 1
+}
+
+// RegionTopology is the number of nodes and zones of one region of a cluster. NumZones is 0
+// when the number of zones is left to the server.
+type RegionTopology struct {
+	Region   string
+	NumNodes int64
+	NumZones int64
+}
+
+// ValidateClusterTopology checks that the regions of a cluster can place every replica of the
+// given fault tolerance in a different fault domain. Geo-partitioned clusters keep all the
+// replicas of a partition in its region, so the node and zone rules apply to every region.
+func ValidateClusterTopology(clusterType string, faultTolerance string, numFaultsToTolerate int64, regions []RegionTopology) []string {
+	var errs []string
+	rf := ReplicationFactor(faultTolerance, numFaultsToTolerate)
+
+	if faultTolerance == "NONE" {
+		if numFaultsToTolerate != 0 {
+			errs = append(errs, "num_faults_to_tolerate must be 0 when fault_tolerance is NONE.")
+		}
+		return errs
+	}
+	if faultTolerance == "ZONE" && numFaultsToTolerate != 1 {
+		errs = append(errs, "num_faults_to_tolerate must be 1 when fault_tolerance is ZONE.")
+	}
+
+	// Every region holds a copy of the data, so every region needs the same number of nodes
+	if faultTolerance == "REGION" {
+		if int64(len(regions)) < rf {
+			errs = append(errs, fmt.Sprintf("Tolerating %d region faults requires at least %d regions, found %d.", numFaultsToTolerate, rf, len(regions)))
+		}
+		for _, region := range regions {
+			if region.NumNodes < 1 {
+				errs = append(errs, fmt.Sprintf("Region %v has %d nodes, every region needs at least 1 node to hold a replica.", region.Region, region.NumNodes))
+			} else if region.NumNodes != regions[0].NumNodes {
+				errs = append(errs, fmt.Sprintf("Region %v has %d nodes and region %v has %d nodes, every region must have the same number of nodes to tolerate a region fault.",
+					region.Region, region.NumNodes, regions[0].Region, regions[0].NumNodes))
+			}
+		}
+		return errs
+	}
+
+	domainName := "nodes"
+	if faultTolerance == "ZONE" {
+		domainName = "zones"
+	}
+	groups := [][]RegionTopology{regions}
+	if clusterType == "GEO_PARTITIONED" {
+		groups = nil
+		for _, region := range regions {
+			groups = append(groups, []RegionTopology{region})
+		}
+	}
+	for _, group := range groups {
+		numNodes := int64(0)
+		for _, region := range group {
+			numNodes += region.NumNodes
+			if faultTolerance == "ZONE" && region.NumZones > 0 && region.NumZones < rf {
+				errs = append(errs, fmt.Sprintf("Region %v has %d zones, at least %d are required to tolerate a zone fault.", region.Region, region.NumZones, rf))
+			}
+		}
+		where := "The cluster"
+		if clusterType == "GEO_PARTITIONED" {
+			where = "Region " + group[0].Region
+		}
+		if numNodes < rf {
+			errs = append(errs, fmt.Sprintf("%v has %d nodes, at least %d are required to place %d replicas on different %v.", where, numNodes, rf, rf, domainName))
+		} else if faultTolerance == "ZONE" && len(group) == 1 && numNodes%rf != 0 {
+			errs = append(errs, fmt.Sprintf("%v has %d nodes, the number of nodes must be a multiple of %d so that every zone has the same number of nodes.", where, numNodes, rf))
+		}
+	}
+	return errs
+}
+
+// ValidateBackupRegions checks the backup regions of a cluster against its regions. Synchronous
+// clusters store their backups in exactly one region, geo-partitioned clusters in one or more.
+func ValidateBackupRegions(clusterType string, clusterRegions []string, backupRegions []string) []string {
+	var errs []string
+	if len(backupRegions) == 0 {
+		return append(errs, "At least one backup region is required.")
+	}
+	if clusterType == "SYNCHRONOUS" && len(backupRegions) > 1 {
+		errs = append(errs, fmt.Sprintf("Synchronous clusters store their backups in a single region, found %d backup regions.", len(backupRegions)))
+	}
+
+	isClusterRegion := map[string]bool{}
+	for _, region := range clusterRegions {
+		isClusterRegion[region] = true
+	}
+	sortedClusterRegions := append([]string{}, clusterRegions...)
+	sort.Strings(sortedClusterRegions)
+	for _, region := range backupRegions {
+		if !isClusterRegion[region] {
+			errs = append(errs, fmt.Sprintf("Region %v is not a region of the cluster, the backup region must be one of: %v.", region, strings.Join(sortedClusterRegions, ", ")))
+		}
+	}
+	return errs
+}
+
+// NodeConfigOption is one entry of the node configuration catalogue of a region. The disk
+// limits are zero when the catalogue does not report them.
+type NodeConfigOption struct {
+	NumCores           int64
+	MemoryMb           int64
+	IncludedDiskSizeGb int64
+	MinDiskSizeGb      int64
+	MinDiskIops        int64
+	MaxDiskIops        int64
+}
+
+// MinDiskSize returns the smallest disk the node can be configured with. The included disk
+// size is only the default, so the tier minimum applies when the catalogue has no limit.
+func (o NodeConfigOption) MinDiskSize(clusterTier string) int64 {
+	if o.MinDiskSizeGb > 0 {
+		return o.MinDiskSizeGb
+	}
+	if clusterTier == "PAID" {
+		return MinPaidDiskSizeGb
+	}
+	return 0
+}
+
+// DiskIopsRange returns the disk IOPS the node can be configured with, from the catalogue when
+// it reports the limits of the node and from DiskIopsRange otherwise.
+func (o NodeConfigOption) DiskIopsRange(cloudType string, clusterTier string) (minIops int64, maxIops int64, configurable bool) {
+	minIops, maxIops, configurable = DiskIopsRange(cloudType, clusterTier)
+	if configurable && o.MinDiskIops > 0 && o.MaxDiskIops >= o.MinDiskIops {
+		return o.MinDiskIops, o.MaxDiskIops, true
+	}
+	return minIops, maxIops, configurable
+}
+
+// NodeConfigError describes a node configuration value the catalogue does not support.
+// Attribute is the name of the offending attribute: num_cores, disk_size_gb or disk_iops.
+type NodeConfigError struct {
+	Attribute string
+	Summary   string
+	Detail    string
+}
+
+// ValidateNodeConfig checks the core count, disk size and disk IOPS of the nodes of a region
+// against the node configurations supported in that region. Unknown or null values are skipped.
+func ValidateNodeConfig(options []NodeConfigOption, cloudType string, clusterTier string, region string, numCores types.Int64, diskSizeGb types.Int64, diskIops types.Int64) []NodeConfigError {
+	var errs []NodeConfigError
+
+	if len(options) == 0 {
+		return append(errs, NodeConfigError{
+			Attribute: "num_cores",
+			Summary:   "Unsupported region " + region,
+			Detail:    fmt.Sprintf("No node configurations are available for %v %v clusters in the region %v.", cloudType, clusterTier, region),
+		})
+	}
+
+	var selected *NodeConfigOption
+	if !numCores.IsNull() && !numCores.IsUnknown() {
+		var supportedCores []string
+		for i := range options {
+			if options[i].NumCores == numCores.Value {
+				selected = &options[i]
+			}
+			supportedCores = append(supportedCores, fmt.Sprint(options[i].NumCores))
+		}
+		if selected == nil {
+			errs = append(errs, NodeConfigError{
+				Attribute: "num_cores",
+				Summary:   "Unsupported number of CPU cores in " + region,
+				Detail:    fmt.Sprintf("Nodes with %v CPU cores are not available in the region %v. Supported values are: %v.", numCores.Value, region, strings.Join(supportedCores, ", ")),
+			})
+		}
+	}
+
+	if !diskSizeGb.IsNull() && !diskSizeGb.IsUnknown() {
+		if selected != nil {
+			if minDiskSize := selected.MinDiskSize(clusterTier); diskSizeGb.Value < minDiskSize {
+				errs = append(errs, NodeConfigError{
+					Attribute: "disk_size_gb",
+					Summary:   "Invalid disk size in " + region,
+					Detail:    fmt.Sprintf("Nodes with %v CPU cores in the region %v require a disk of at least %v GB.", selected.NumCores, region, minDiskSize),
+				})
+			}
+		} else if !IsDiskSizeValid(clusterTier, diskSizeGb.Value) {
+			errs = append(errs, NodeConfigError{
+				Attribute: "disk_size_gb",
+				Summary:   "Invalid disk size in " + region,
+				Detail:    fmt.Sprintf("The disk size for a %v cluster must be at least %v GB.", clusterTier, MinPaidDiskSizeGb),
+			})
+		}
+	}
+
+	if !diskIops.IsNull() && !diskIops.IsUnknown() {
+		if selected == nil {
+			if isValid, message := IsDiskIopsValid(cloudType, clusterTier, diskIops.Value); !isValid {
+				errs = append(errs, NodeConfigError{
+					Attribute: "disk_iops",
+					Summary:   "Invalid disk IOPS in " + region,
+					Detail:    message,
+				})
+			}
+		} else if isValid, message := isDiskIopsInRange(*selected, cloudType, clusterTier, diskIops.Value); !isValid {
+			errs = append(errs, NodeConfigError{
+				Attribute: "disk_iops",
+				Summary:   "Invalid disk IOPS in " + region,
+				Detail:    fmt.Sprintf("Nodes with %v CPU cores in the region %v: %v", selected.NumCores, region, message),
+			})
+		}
+	}
+
+	return errs
+}
+
+// isDiskIopsInRange checks the disk IOPS against the limits the catalogue reports for a node.
+func isDiskIopsInRange(option NodeConfigOption, cloudType string, clusterTier string, diskIops int64) (bool, string) {
+	minIops, maxIops, configurable := option.DiskIopsRange(cloudType, clusterTier)
+	if !configurable {
+		// diskIops = 0 is a default value in stateFile for cloud != AWS
+		if diskIops != 0 {
+			return false, fmt.Sprintf("Custom Disk IOPS is not supported for %v", cloudType)
+		}
+		return true, ""
+	}
+	if diskIops%DiskIopsStep != 0 {
+		return false, fmt.Sprintf("Disk IOPS must be a multiple of %d", DiskIopsStep)
+	}
+	if diskIops < minIops || diskIops > maxIops {
+		return false, fmt.Sprintf("Disk IOPS must be between %d and %d (inclusive)", minIops, maxIops)
+	}
+	return true, ""
+}
+
+// RestoreRename is a YSQL database or YCQL keyspace of a backup restored under another name.
+type RestoreRename struct {
+	BackupDatabase  string
+	RestoreDatabase string
+}
+
+// RestoreSelection is what a restore takes from a backup. Empty lists of databases or keyspaces
+// restore all of them.
+type RestoreSelection struct {
+	YSQLDatabases []string
+	YCQLKeyspaces []string
+	YSQLRenames   []RestoreRename
+	YCQLRenames   []RestoreRename
+}
+
+// RestoreSelectionError describes an entry of a restore selection that cannot be restored.
+// Attribute is the name of the list the entry is in and Index its position in that list.
+type RestoreSelectionError struct {
+	Attribute string
+	Index     int
+	Summary   string
+	Detail    string
+}
+
+// ValidateRestoreSelection checks that the databases and keyspaces a restore selects and renames
+// are in the backup, and that the renamed ones do not collide with the namespaces that already
+// exist on the target cluster. The membership checks are skipped when the backup contents are
+// not known. The backup lists its databases and keyspaces as names only, so a name selected as a
+// YCQL keyspace passes when the backup holds it as a YSQL database, and the other way round.
+func ValidateRestoreSelection(selection RestoreSelection, backupDatabases []string, targetYSQLDatabases []string, targetYCQLKeyspaces []string) []RestoreSelectionError {
+	errs := validateRestoreNamespaces("YSQL database", "ysql_databases", "ysql_databases_rename", selection.YSQLDatabases, selection.YSQLRenames, backupDatabases, targetYSQLDatabases)
+	return append(errs, validateRestoreNamespaces("YCQL keyspace", "ycql_keyspaces", "ycql_keyspaces_rename", selection.YCQLKeyspaces, selection.YCQLRenames, backupDatabases, targetYCQLKeyspaces)...)
+}
+
+func validateRestoreNamespaces(kind string, selectedAttribute string, renameAttribute string, selected []string, renames []RestoreRename, backupDatabases []string, targetNamespaces []string) []RestoreSelectionError {
+	var errs []RestoreSelectionError
+	inBackup := map[string]bool{}
+	for _, name := range backupDatabases {
+		inBackup[name] = true
+	}
+	isSelected := map[string]bool{}
+	for _, name := range selected {
+		isSelected[name] = true
+	}
+	onTarget := map[string]bool{}
+	for _, name := range targetNamespaces {
+		onTarget[name] = true
+	}
+	backupContents := strings.Join(backupDatabases, ", ")
+
+	for i, name := range selected {
+		if len(inBackup) > 0 && !inBackup[name] {
+			errs = append(errs, RestoreSelectionError{
+				Attribute: selectedAttribute,
+				Index:     i,
+				Summary:   "Database not in backup",
+				Detail:    fmt.Sprintf("The %s %s is not in the backup. The backup contains: %s.", kind, name, backupContents),
+			})
+		}
+	}
+
+	renamedTo := map[string]bool{}
+	for i, rename := range renames {
+		switch {
+		case len(inBackup) > 0 && !inBackup[rename.BackupDatabase]:
+			errs = append(errs, RestoreSelectionError{
+				Attribute: renameAttribute,
+				Index:     i,
+				Summary:   "Database not in backup",
+				Detail:    fmt.Sprintf("The %s %s is renamed but is not in the backup. The backup contains: %s.", kind, rename.BackupDatabase, backupContents),
+			})
+		case len(isSelected) > 0 && !isSelected[rename.BackupDatabase]:
+			errs = append(errs, RestoreSelectionError{
+				Attribute: renameAttribute,
+				Index:     i,
+				Summary:   "Renamed database not restored",
+				Detail:    fmt.Sprintf("The %s %s is renamed but is not restored. Add it to %s.", kind, rename.BackupDatabase, selectedAttribute),
+			})
+		case onTarget[rename.RestoreDatabase]:
+			errs = append(errs, RestoreSelectionError{
+				Attribute: renameAttribute,
+				Index:     i,
+				Summary:   "Database already exists",
+				Detail:    fmt.Sprintf("The %s %s already exists on the target cluster. Rename %s to a name that is not in use.", kind, rename.RestoreDatabase, rename.BackupDatabase),
+			})
+		case renamedTo[rename.RestoreDatabase]:
+			errs = append(errs, RestoreSelectionError{
+				Attribute: renameAttribute,
+				Index:     i,
+				Summary:   "Duplicate database name",
+				Detail:    fmt.Sprintf("Several %ss are renamed to %s.", kind, rename.RestoreDatabase),
+			})
+		}
+		renamedTo[rename.RestoreDatabase] = true
+	}
+	return errs
+}
+
+// IsRestoreVersionCompatible reports whether a backup taken on one database version can be
+// restored onto a cluster running another. Backups cannot be restored onto older versions.
+// Unknown versions are accepted.
+func IsRestoreVersionCompatible(backupVersion string, clusterVersion string) (bool, string) {
+	if backupVersion == "" || clusterVersion == "" {
+		return true, ""
+	}
+	if CompareDatabaseVersions(backupVersion, clusterVersion) > 0 {
+		return false, fmt.Sprintf("The backup was taken on database version %s and cannot be restored onto a cluster running the older version %s. Upgrade the cluster first.", backupVersion, clusterVersion)
+	}
+	return true, ""
+}
+
+// IsAutoscaledDiskSize reports whether a disk size read back from the server can be
+// explained by storage autoscaling, i.e. it grew beyond the configured size but not past
+// the policy's maximum. Such growth should be accepted instead of planning a shrink.
+func IsAutoscaledDiskSize(configuredSize int64, actualSize int64, maxSize int64) bool {
+	return configuredSize > 0 && actualSize > configuredSize && actualSize <= maxSize
+}
+
+// IsStorageAutoscalingPolicyValid checks that the policy can grow the disk from the
+// configured size at least once without exceeding the maximum size.
+func IsStorageAutoscalingPolicyValid(diskSize int64, incrementGb int64, maxSize int64) (bool, string) {
+	if maxSize < diskSize {
+		return false, fmt.Sprintf("max_disk_size_gb (%d) must not be less than the configured disk size (%d)", maxSize, diskSize)
+	}
+	if diskSize+incrementGb > maxSize {
+		return false, fmt.Sprintf("max_disk_size_gb (%d) leaves no room for a single increment of %d GB over the configured disk size (%d)", maxSize, incrementGb, diskSize)
+	}
+	return true, ""
+}
+
+const (
+	YSQLPort = 5433
+	YCQLPort = 9042
+)
+
+// YSQLConnectionURI builds a postgresql:// URI that verifies the server certificate against rootCertPath.
+// The user is left out of the URI when empty; the password is never part of it.
+func YSQLConnectionURI(host string, username string, database string, rootCertPath string) string {
+	uri := url.URL{
+		Scheme:   "postgresql",
+		Host:     fmt.Sprintf("%s:%d", host, YSQLPort),
+		Path:     "/" + database,
+		RawQuery: "sslmode=verify-full&sslrootcert=" + url.QueryEscape(rootCertPath),
+	}
+	if username != "" {
+		uri.User = url.User(username)
+	}
+	return uri.String()
+}
+
+// JDBCConnectionURL builds a jdbc:yugabytedb:// URL that verifies the server certificate against rootCertPath.
+func JDBCConnectionURL(host string, username string, database string, rootCertPath string) string {
+	query := "sslmode=verify-full&sslrootcert=" + url.QueryEscape(rootCertPath)
+	if username != "" {
+		query += "&user=" + url.QueryEscape(username)
+	}
+	return fmt.Sprintf("jdbc:yugabytedb://%s:%d/%s?%s", host, YSQLPort, url.PathEscape(database), query)
+}
+
+// YCQLContactPoint returns the host:port contact point of a YCQL endpoint.
+func YCQLContactPoint(host string) string {
+	return fmt.Sprintf("%s:%d", host, YCQLPort)
+}
+
+// SmartDriverLoadBalanceParams returns the query parameters that make the YugabyteDB smart drivers
+// balance connections across the nodes of the given region. JDBC uses hyphenated parameter names,
+// the other smart drivers use underscores.
+func SmartDriverLoadBalanceParams(cloud string, region string, jdbc bool) string {
+	topologyKeys := fmt.Sprintf("%s.%s.*", strings.ToLower(cloud), region)
+	if jdbc {
+		return "load-balance=true&topology-keys=" + topologyKeys
+	}
+	return "load_balance=true&topology_keys=" + topologyKeys
+}
+
+// UpgradeListToMapByKey rewrites the list of objects stored under attribute in a JSON encoded
+// state into a map of the same objects, keyed by the value of their keyAttribute. It is used by
+// state upgraders when a list nested attribute becomes a map nested attribute. A null or missing
+// list is left untouched.
+func UpgradeListToMapByKey(rawState []byte, attribute string, keyAttribute string) ([]byte, error) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(rawState, &state); err != nil {
+		return nil, fmt.Errorf("unable to decode the prior state: %v", err)
+	}
+
+	rawList, ok := state[attribute]
+	if !ok || string(rawList) == "null" {
+		return rawState, nil
+	}
+	var elements []map[string]json.RawMessage
+	if err := json.Unmarshal(rawList, &elements); err != nil {
+		return nil, fmt.Errorf("unable to decode %s in the prior state: %v", attribute, err)
+	}
+
+	elementsByKey := make(map[string]map[string]json.RawMessage, len(elements))
+	for i, element := range elements {
+		var key string
+		if err := json.Unmarshal(element[keyAttribute], &key); err != nil || key == "" {
+			return nil, fmt.Errorf("%s[%d] in the prior state has no %s", attribute, i, keyAttribute)
+		}
+		if _, exists := elementsByKey[key]; exists {
+			return nil, fmt.Errorf("%s in the prior state has more than one element with %s %s", attribute, keyAttribute, key)
+		}
+		elementsByKey[key] = element
+	}
+
+	rawMap, err := json.Marshal(elementsByKey)
+	if err != nil {
+		return nil, err
+	}
+	state[attribute] = rawMap
+	return json.Marshal(state)
+}
+
+var awsKMSKeyARNRegex = regexp.MustCompile(`^arn:aws(-[a-z]+)*:kms:[a-z0-9-]+:[0-9]{12}:(key|alias)/[A-Za-z0-9/_+=,.@-]+$`)
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+var azureKeyVaultHostSuffixes = []string{".vault.azure.net", ".vault.azure.cn", ".vault.usgovcloudapi.net", ".vault.microsoftazure.de"}
+
+// IsAWSKMSKeyARNValid reports whether arn is the ARN of an AWS KMS key or key alias.
+func IsAWSKMSKeyARNValid(arn string) bool {
+	return awsKMSKeyARNRegex.MatchString(arn)
+}
+
+// IsUUIDValid reports whether s is a UUID, as used for Azure client and tenant IDs.
+func IsUUIDValid(s string) bool {
+	return uuidRegex.MatchString(s)
+}
+
+// IsAzureKeyVaultURIValid reports whether uri is the https URI of an Azure Key Vault,
+// for example https://my-vault.vault.azure.net/.
+func IsAzureKeyVaultURIValid(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "https" || (parsed.Path != "" && parsed.Path != "/") || parsed.RawQuery != "" {
+		return false
+	}
+	for _, suffix := range azureKeyVaultHostSuffixes {
+		if strings.HasSuffix(parsed.Host, suffix) && len(parsed.Host) > len(suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// GCPKMSKeyName returns the resource name of a GCP KMS key.
+func GCPKMSKeyName(project string, location string, keyRing string, key string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/keyRings/%s/cryptoKeys/%s", project, location, keyRing, key)
+}
+
+// AzureKeyVaultKeyID returns the identifier of a key in an Azure Key Vault.
+func AzureKeyVaultKeyID(keyVaultURI string, keyName string) string {
+	return strings.TrimSuffix(keyVaultURI, "/") + "/keys/" + keyName
+}
+
 // Inspired from here:
 // https://stackoverflow.com/questions/37562873/most-idiomatic-way-to-select-elements-from-an-array-in-golang
 // This allows us to filter a slice of any type using a function that returns a bool
@@ -6,6 +6,8 @@ package util
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestAreListsEqual(t *testing.T) {
@@ -87,3 +89,1263 @@ func TestIsDiskSizeValid(t *testing.T) {
 		})
 	}
 }
+
+func TestIsClusterTierChangeValid(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		FromTier      string
+		ToTier        string
+		ExpectedValid bool
+	}{
+		{
+			TestName:      "Upgrade FREE to PAID",
+			FromTier:      "FREE",
+			ToTier:        "PAID",
+			ExpectedValid: true,
+		},
+		{
+			TestName:      "Downgrade PAID to FREE",
+			FromTier:      "PAID",
+			ToTier:        "FREE",
+			ExpectedValid: false,
+		},
+		{
+			TestName:      "Unchanged PAID",
+			FromTier:      "PAID",
+			ToTier:        "PAID",
+			ExpectedValid: true,
+		},
+		{
+			TestName:      "Unchanged FREE",
+			FromTier:      "FREE",
+			ToTier:        "FREE",
+			ExpectedValid: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotValid, message := IsClusterTierChangeValid(testCase.FromTier, testCase.ToTier)
+			if gotValid != testCase.ExpectedValid {
+				t.Errorf("IsClusterTierChangeValid(%v,%v) = %v, %q; want %v", testCase.FromTier, testCase.ToTier, gotValid, message, testCase.ExpectedValid)
+			}
+			if !gotValid && message == "" {
+				t.Errorf("IsClusterTierChangeValid(%v,%v) returned no message for an invalid change", testCase.FromTier, testCase.ToTier)
+			}
+		})
+	}
+}
+
+func TestIsTaskRunning(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		State            string
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "In progress",
+			State:            "IN_PROGRESS",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Queued",
+			State:            "QUEUED",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Succeeded",
+			State:            "SUCCEEDED",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Failed",
+			State:            "FAILED",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Cancelled in lower case",
+			State:            "cancelled",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Unknown",
+			State:            "",
+			ExpectedResponse: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := IsTaskRunning(testCase.State)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("IsTaskRunning(%v) = %v; want %v", testCase.State, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestBackupType(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		ActionType       string
+		Incremental      bool
+		ExpectedResponse string
+	}{
+		{
+			TestName:         "Scheduled backup",
+			ActionType:       "SCHEDULED",
+			Incremental:      false,
+			ExpectedResponse: "SCHEDULED",
+		},
+		{
+			TestName:         "Scheduled backup in lower case",
+			ActionType:       "scheduled_backup",
+			Incremental:      false,
+			ExpectedResponse: "SCHEDULED",
+		},
+		{
+			TestName:         "On demand backup",
+			ActionType:       "ON_DEMAND",
+			Incremental:      false,
+			ExpectedResponse: "ON_DEMAND",
+		},
+		{
+			TestName:         "Incremental scheduled backup",
+			ActionType:       "SCHEDULED",
+			Incremental:      true,
+			ExpectedResponse: "INCREMENTAL",
+		},
+		{
+			TestName:         "Unknown action",
+			ActionType:       "",
+			Incremental:      false,
+			ExpectedResponse: "ON_DEMAND",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := BackupType(testCase.ActionType, testCase.Incremental)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("BackupType(%v, %v) = %v; want %v", testCase.ActionType, testCase.Incremental, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestDurationSeconds(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Start            string
+		End              string
+		ExpectedResponse int64
+		ExpectedOK       bool
+	}{
+		{
+			TestName:         "Completed operation",
+			Start:            "2024-01-02T15:04:05Z",
+			End:              "2024-01-02T15:34:35Z",
+			ExpectedResponse: 1830,
+			ExpectedOK:       true,
+		},
+		{
+			TestName:         "Fractional seconds and time zones",
+			Start:            "2024-01-02T15:04:05.500Z",
+			End:              "2024-01-02T16:04:06.500+01:00",
+			ExpectedResponse: 1,
+			ExpectedOK:       true,
+		},
+		{
+			TestName:         "Running operation",
+			Start:            "2024-01-02T15:04:05Z",
+			End:              "",
+			ExpectedResponse: 0,
+			ExpectedOK:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse, gotOK := DurationSeconds(testCase.Start, testCase.End)
+			if gotResponse != testCase.ExpectedResponse || gotOK != testCase.ExpectedOK {
+				t.Errorf("DurationSeconds(%v, %v) = %v, %v; want %v, %v", testCase.Start, testCase.End, gotResponse, gotOK, testCase.ExpectedResponse, testCase.ExpectedOK)
+			}
+		})
+	}
+}
+
+func TestCanonicalTrackName(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		TrackName        string
+		ExpectedResponse string
+	}{
+		{
+			TestName:         "Production is Extended",
+			TrackName:        "Production",
+			ExpectedResponse: "Extended",
+		},
+		{
+			TestName:         "Innovation is Extended",
+			TrackName:        "Innovation",
+			ExpectedResponse: "Extended",
+		},
+		{
+			TestName:         "Preview is Rapid",
+			TrackName:        "Preview",
+			ExpectedResponse: "Rapid",
+		},
+		{
+			TestName:         "Early Access is Rapid",
+			TrackName:        "Early Access",
+			ExpectedResponse: "Rapid",
+		},
+		{
+			TestName:         "Current track name",
+			TrackName:        "Extended",
+			ExpectedResponse: "Extended",
+		},
+		{
+			TestName:         "Unknown track name",
+			TrackName:        "Stable",
+			ExpectedResponse: "Stable",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := CanonicalTrackName(testCase.TrackName)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("CanonicalTrackName(%v) = %v; want %v", testCase.TrackName, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestTrackAliases(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		TrackName        string
+		ExpectedResponse []string
+	}{
+		{
+			TestName:         "Extended",
+			TrackName:        "Extended",
+			ExpectedResponse: []string{"Innovation", "Production"},
+		},
+		{
+			TestName:         "Rapid",
+			TrackName:        "Rapid",
+			ExpectedResponse: []string{"Early Access", "Preview"},
+		},
+		{
+			TestName:         "Track without aliases",
+			TrackName:        "Production",
+			ExpectedResponse: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := TrackAliases(testCase.TrackName)
+			if !AreListsEqual(gotResponse, testCase.ExpectedResponse) {
+				t.Errorf("TrackAliases(%v) = %v; want %v", testCase.TrackName, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestCompareDatabaseVersions(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Version1         string
+		Version2         string
+		ExpectedResponse int
+	}{
+		{
+			TestName:         "Same version",
+			Version1:         "2.20.1.0-b97",
+			Version2:         "2.20.1.0-b97",
+			ExpectedResponse: 0,
+		},
+		{
+			TestName:         "Older minor version",
+			Version1:         "2.18.7.0-b38",
+			Version2:         "2.20.1.0-b97",
+			ExpectedResponse: -1,
+		},
+		{
+			TestName:         "Newer minor version compared numerically",
+			Version1:         "2.20.1.0-b97",
+			Version2:         "2.9.1.0-b97",
+			ExpectedResponse: 1,
+		},
+		{
+			TestName:         "Newer build",
+			Version1:         "2.20.1.0-b120",
+			Version2:         "2.20.1.0-b97",
+			ExpectedResponse: 1,
+		},
+		{
+			TestName:         "Missing build number",
+			Version1:         "2.20.1.0",
+			Version2:         "2.20.1.0-b97",
+			ExpectedResponse: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := CompareDatabaseVersions(testCase.Version1, testCase.Version2)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("CompareDatabaseVersions(%v, %v) = %v; want %v", testCase.Version1, testCase.Version2, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestReplicationFactor(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		FaultTolerance      string
+		NumFaultsToTolerate int64
+		ExpectedRF          int64
+	}{
+		{
+			TestName:            "No fault tolerance",
+			FaultTolerance:      "NONE",
+			NumFaultsToTolerate: 0,
+			ExpectedRF:          1,
+		},
+		{
+			TestName:            "Zone fault tolerance",
+			FaultTolerance:      "ZONE",
+			NumFaultsToTolerate: 1,
+			ExpectedRF:          3,
+		},
+		{
+			TestName:            "Two node faults",
+			FaultTolerance:      "NODE",
+			NumFaultsToTolerate: 2,
+			ExpectedRF:          5,
+		},
+		{
+			TestName:            "Three region faults",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 3,
+			ExpectedRF:          7,
+		},
+		{
+			TestName:            "Default number of faults",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 0,
+			ExpectedRF:          3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotRF := ReplicationFactor(testCase.FaultTolerance, testCase.NumFaultsToTolerate)
+			if gotRF != testCase.ExpectedRF {
+				t.Errorf("ReplicationFactor(%v,%v) = %v; want %v", testCase.FaultTolerance, testCase.NumFaultsToTolerate, gotRF, testCase.ExpectedRF)
+			}
+		})
+	}
+}
+
+func TestValidateClusterTopology(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		ClusterType         string
+		FaultTolerance      string
+		NumFaultsToTolerate int64
+		Regions             []RegionTopology
+		ExpectedErrors      int
+	}{
+		{
+			TestName:       "Single node without fault tolerance",
+			ClusterType:    "SYNCHRONOUS",
+			FaultTolerance: "NONE",
+			Regions:        []RegionTopology{{Region: "us-west-2", NumNodes: 1}},
+			ExpectedErrors: 0,
+		},
+		{
+			TestName:            "Faults to tolerate without fault tolerance",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "NONE",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 1}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Zone fault tolerance with three nodes",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "ZONE",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3, NumZones: 3}},
+			ExpectedErrors:      0,
+		},
+		{
+			TestName:            "Zone fault tolerance with too few zones",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "ZONE",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3, NumZones: 1}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Zone fault tolerance with uneven nodes",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "ZONE",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 4}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Two node faults with too few nodes",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "NODE",
+			NumFaultsToTolerate: 2,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Two node faults across regions",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "NODE",
+			NumFaultsToTolerate: 2,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3}, {Region: "us-east-1", NumNodes: 2}},
+			ExpectedErrors:      0,
+		},
+		{
+			TestName:            "Geo-partitioned region with too few nodes",
+			ClusterType:         "GEO_PARTITIONED",
+			FaultTolerance:      "ZONE",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3}, {Region: "eu-west-1", NumNodes: 1}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Region fault tolerance with three regions",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 1}, {Region: "us-east-1", NumNodes: 1}, {Region: "eu-west-1", NumNodes: 1}},
+			ExpectedErrors:      0,
+		},
+		{
+			TestName:            "Region fault tolerance with a region without nodes",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 1}, {Region: "us-east-1", NumNodes: 1}, {Region: "eu-west-1", NumNodes: 0}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Region fault tolerance with uneven regions",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 1,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 3}, {Region: "us-east-1", NumNodes: 3}, {Region: "eu-west-1", NumNodes: 2}},
+			ExpectedErrors:      1,
+		},
+		{
+			TestName:            "Two region faults with three regions",
+			ClusterType:         "SYNCHRONOUS",
+			FaultTolerance:      "REGION",
+			NumFaultsToTolerate: 2,
+			Regions:             []RegionTopology{{Region: "us-west-2", NumNodes: 1}, {Region: "us-east-1", NumNodes: 1}, {Region: "eu-west-1", NumNodes: 1}},
+			ExpectedErrors:      1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotErrors := ValidateClusterTopology(testCase.ClusterType, testCase.FaultTolerance, testCase.NumFaultsToTolerate, testCase.Regions)
+			if len(gotErrors) != testCase.ExpectedErrors {
+				t.Errorf("ValidateClusterTopology(%v,%v,%v,%v) = %v; want %d errors", testCase.ClusterType, testCase.FaultTolerance, testCase.NumFaultsToTolerate, testCase.Regions, gotErrors, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
+
+func TestValidateBackupRegions(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		ClusterType    string
+		ClusterRegions []string
+		BackupRegions  []string
+		ExpectedErrors int
+	}{
+		{
+			TestName:       "Synchronous cluster with one backup region",
+			ClusterType:    "SYNCHRONOUS",
+			ClusterRegions: []string{"us-west-2", "us-east-1", "eu-west-1"},
+			BackupRegions:  []string{"us-east-1"},
+			ExpectedErrors: 0,
+		},
+		{
+			TestName:       "Synchronous cluster with two backup regions",
+			ClusterType:    "SYNCHRONOUS",
+			ClusterRegions: []string{"us-west-2", "us-east-1", "eu-west-1"},
+			BackupRegions:  []string{"us-east-1", "us-west-2"},
+			ExpectedErrors: 1,
+		},
+		{
+			TestName:       "Geo-partitioned cluster with a backup region per region",
+			ClusterType:    "GEO_PARTITIONED",
+			ClusterRegions: []string{"us-west-2", "eu-west-1"},
+			BackupRegions:  []string{"us-west-2", "eu-west-1"},
+			ExpectedErrors: 0,
+		},
+		{
+			TestName:       "Backup region outside of the cluster",
+			ClusterType:    "GEO_PARTITIONED",
+			ClusterRegions: []string{"us-west-2", "eu-west-1"},
+			BackupRegions:  []string{"us-west-2", "ap-south-1"},
+			ExpectedErrors: 1,
+		},
+		{
+			TestName:       "No backup region",
+			ClusterType:    "SYNCHRONOUS",
+			ClusterRegions: []string{"us-west-2"},
+			BackupRegions:  []string{},
+			ExpectedErrors: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotErrors := ValidateBackupRegions(testCase.ClusterType, testCase.ClusterRegions, testCase.BackupRegions)
+			if len(gotErrors) != testCase.ExpectedErrors {
+				t.Errorf("ValidateBackupRegions(%v,%v,%v) = %v; want %d errors", testCase.ClusterType, testCase.ClusterRegions, testCase.BackupRegions, gotErrors, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
+
+func TestDiskIopsRange(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		CloudType            string
+		ClusterTier          string
+		ExpectedMin          int64
+		ExpectedMax          int64
+		ExpectedConfigurable bool
+	}{
+		{
+			TestName:             "AWS paid cluster",
+			CloudType:            "AWS",
+			ClusterTier:          "PAID",
+			ExpectedMin:          3000,
+			ExpectedMax:          16000,
+			ExpectedConfigurable: true,
+		},
+		{
+			TestName:             "AWS free cluster",
+			CloudType:            "AWS",
+			ClusterTier:          "FREE",
+			ExpectedMin:          3000,
+			ExpectedMax:          3000,
+			ExpectedConfigurable: true,
+		},
+		{
+			TestName:             "GCP paid cluster",
+			CloudType:            "GCP",
+			ClusterTier:          "PAID",
+			ExpectedMin:          0,
+			ExpectedMax:          0,
+			ExpectedConfigurable: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotMin, gotMax, gotConfigurable := DiskIopsRange(testCase.CloudType, testCase.ClusterTier)
+			if gotMin != testCase.ExpectedMin || gotMax != testCase.ExpectedMax || gotConfigurable != testCase.ExpectedConfigurable {
+				t.Errorf("DiskIopsRange(%v,%v) = %v, %v, %v; want %v, %v, %v", testCase.CloudType, testCase.ClusterTier,
+					gotMin, gotMax, gotConfigurable, testCase.ExpectedMin, testCase.ExpectedMax, testCase.ExpectedConfigurable)
+			}
+			if gotConfigurable {
+				if isValid, message := IsDiskIopsValid(testCase.CloudType, testCase.ClusterTier, gotMax); !isValid {
+					t.Errorf("IsDiskIopsValid(%v,%v,%v) = %v; the maximum of the range must be valid", testCase.CloudType, testCase.ClusterTier, gotMax, message)
+				}
+			}
+		})
+	}
+}
+
+func TestIsAutoscaledDiskSize(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		ConfiguredSize   int64
+		ActualSize       int64
+		MaxSize          int64
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "Disk grew within the policy",
+			ConfiguredSize:   100,
+			ActualSize:       120,
+			MaxSize:          200,
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Disk grew up to the maximum",
+			ConfiguredSize:   100,
+			ActualSize:       200,
+			MaxSize:          200,
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Disk grew beyond the maximum",
+			ConfiguredSize:   100,
+			ActualSize:       220,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Disk size unchanged",
+			ConfiguredSize:   100,
+			ActualSize:       100,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Disk shrank",
+			ConfiguredSize:   100,
+			ActualSize:       80,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "No configured size",
+			ConfiguredSize:   0,
+			ActualSize:       120,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse := IsAutoscaledDiskSize(testCase.ConfiguredSize, testCase.ActualSize, testCase.MaxSize)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("IsAutoscaledDiskSize(%v,%v,%v) = %v; want %v", testCase.ConfiguredSize, testCase.ActualSize, testCase.MaxSize, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestIsStorageAutoscalingPolicyValid(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		DiskSize         int64
+		IncrementGb      int64
+		MaxSize          int64
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "Room for several increments",
+			DiskSize:         100,
+			IncrementGb:      20,
+			MaxSize:          200,
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Room for exactly one increment",
+			DiskSize:         100,
+			IncrementGb:      100,
+			MaxSize:          200,
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "No room for an increment",
+			DiskSize:         190,
+			IncrementGb:      20,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Maximum below the disk size",
+			DiskSize:         250,
+			IncrementGb:      20,
+			MaxSize:          200,
+			ExpectedResponse: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse, _ := IsStorageAutoscalingPolicyValid(testCase.DiskSize, testCase.IncrementGb, testCase.MaxSize)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("IsStorageAutoscalingPolicyValid(%v,%v,%v) = %v; want %v", testCase.DiskSize, testCase.IncrementGb, testCase.MaxSize, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestNodeConfigOptionDiskIopsRange(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		Option               NodeConfigOption
+		CloudType            string
+		ExpectedMin          int64
+		ExpectedMax          int64
+		ExpectedConfigurable bool
+	}{
+		{
+			TestName:             "Limits from the catalogue",
+			Option:               NodeConfigOption{NumCores: 8, MinDiskIops: 4000, MaxDiskIops: 12000},
+			CloudType:            "AWS",
+			ExpectedMin:          4000,
+			ExpectedMax:          12000,
+			ExpectedConfigurable: true,
+		},
+		{
+			TestName:             "Default limits",
+			Option:               NodeConfigOption{NumCores: 2},
+			CloudType:            "AWS",
+			ExpectedMin:          MinDiskIops,
+			ExpectedMax:          MaxDiskIops,
+			ExpectedConfigurable: true,
+		},
+		{
+			TestName:             "Not configurable",
+			Option:               NodeConfigOption{NumCores: 8, MinDiskIops: 4000, MaxDiskIops: 12000},
+			CloudType:            "GCP",
+			ExpectedConfigurable: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotMin, gotMax, gotConfigurable := testCase.Option.DiskIopsRange(testCase.CloudType, "PAID")
+			if gotMin != testCase.ExpectedMin || gotMax != testCase.ExpectedMax || gotConfigurable != testCase.ExpectedConfigurable {
+				t.Errorf("DiskIopsRange(%v,PAID) = %v, %v, %v; want %v, %v, %v", testCase.CloudType,
+					gotMin, gotMax, gotConfigurable, testCase.ExpectedMin, testCase.ExpectedMax, testCase.ExpectedConfigurable)
+			}
+		})
+	}
+}
+
+func TestValidateNodeConfig(t *testing.T) {
+	options := []NodeConfigOption{
+		{NumCores: 2, MemoryMb: 8192, IncludedDiskSizeGb: 50},
+		{NumCores: 4, MemoryMb: 16384, IncludedDiskSizeGb: 100},
+		{NumCores: 8, MemoryMb: 32768, IncludedDiskSizeGb: 200, MinDiskSizeGb: 100, MinDiskIops: 4000, MaxDiskIops: 12000},
+	}
+	testCases := []struct {
+		TestName           string
+		Options            []NodeConfigOption
+		CloudType          string
+		NumCores           types.Int64
+		DiskSizeGb         types.Int64
+		DiskIops           types.Int64
+		ExpectedAttributes []string
+	}{
+		{
+			TestName:           "Supported configuration",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 4},
+			DiskSizeGb:         types.Int64{Value: 200},
+			DiskIops:           types.Int64{Value: 6000},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName:           "Unknown values are skipped",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Unknown: true},
+			DiskSizeGb:         types.Int64{Unknown: true},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName:           "Unsupported number of cores",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 3},
+			DiskSizeGb:         types.Int64{Value: 100},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: []string{"num_cores"},
+		},
+		{
+			TestName:           "Disk smaller than the included disk",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 4},
+			DiskSizeGb:         types.Int64{Value: 60},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName:           "Disk below the catalogue minimum",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 8},
+			DiskSizeGb:         types.Int64{Value: 60},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: []string{"disk_size_gb"},
+		},
+		{
+			TestName:           "IOPS within the catalogue limits",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 8},
+			DiskSizeGb:         types.Int64{Value: 100},
+			DiskIops:           types.Int64{Value: 12000},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName:           "IOPS outside the catalogue limits",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 8},
+			DiskSizeGb:         types.Int64{Value: 100},
+			DiskIops:           types.Int64{Value: 3000},
+			ExpectedAttributes: []string{"disk_iops"},
+		},
+		{
+			TestName:           "Disk below the paid tier minimum",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 2},
+			DiskSizeGb:         types.Int64{Value: 40},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: []string{"disk_size_gb"},
+		},
+		{
+			TestName:           "Custom IOPS outside AWS",
+			Options:            options,
+			CloudType:          "GCP",
+			NumCores:           types.Int64{Value: 2},
+			DiskSizeGb:         types.Int64{Null: true},
+			DiskIops:           types.Int64{Value: 5000},
+			ExpectedAttributes: []string{"disk_iops"},
+		},
+		{
+			TestName:           "Several invalid values",
+			Options:            options,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 16},
+			DiskSizeGb:         types.Int64{Value: 10},
+			DiskIops:           types.Int64{Value: 2500},
+			ExpectedAttributes: []string{"num_cores", "disk_size_gb", "disk_iops"},
+		},
+		{
+			TestName:           "Region without node configurations",
+			Options:            nil,
+			CloudType:          "AWS",
+			NumCores:           types.Int64{Value: 2},
+			DiskSizeGb:         types.Int64{Null: true},
+			DiskIops:           types.Int64{Null: true},
+			ExpectedAttributes: []string{"num_cores"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			errs := ValidateNodeConfig(testCase.Options, testCase.CloudType, "PAID", "us-west-2", testCase.NumCores, testCase.DiskSizeGb, testCase.DiskIops)
+			var gotAttributes []string
+			for _, err := range errs {
+				gotAttributes = append(gotAttributes, err.Attribute)
+			}
+			if !AreListsEqual(gotAttributes, testCase.ExpectedAttributes) {
+				t.Errorf("ValidateNodeConfig(...) reported %v; want %v", gotAttributes, testCase.ExpectedAttributes)
+			}
+		})
+	}
+}
+
+func TestValidateRestoreSelection(t *testing.T) {
+	backupDatabases := []string{"yugabyte", "orders", "events"}
+	testCases := []struct {
+		TestName           string
+		Selection          RestoreSelection
+		BackupDatabases    []string
+		TargetYSQL         []string
+		TargetYCQL         []string
+		ExpectedAttributes []string
+	}{
+		{
+			TestName: "Valid selection",
+			Selection: RestoreSelection{
+				YSQLDatabases: []string{"orders"},
+				YSQLRenames:   []RestoreRename{{BackupDatabase: "orders", RestoreDatabase: "orders_copy"}},
+			},
+			BackupDatabases:    backupDatabases,
+			TargetYSQL:         []string{"yugabyte", "orders"},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName: "Database not in backup",
+			Selection: RestoreSelection{
+				YSQLDatabases: []string{"order"},
+			},
+			BackupDatabases:    backupDatabases,
+			ExpectedAttributes: []string{"ysql_databases"},
+		},
+		{
+			TestName: "Keyspace not in backup",
+			Selection: RestoreSelection{
+				YCQLKeyspaces: []string{"events", "metrics"},
+			},
+			BackupDatabases:    backupDatabases,
+			ExpectedAttributes: []string{"ycql_keyspaces"},
+		},
+		{
+			TestName: "Renamed database not restored",
+			Selection: RestoreSelection{
+				YSQLDatabases: []string{"yugabyte"},
+				YSQLRenames:   []RestoreRename{{BackupDatabase: "orders", RestoreDatabase: "orders_copy"}},
+			},
+			BackupDatabases:    backupDatabases,
+			ExpectedAttributes: []string{"ysql_databases_rename"},
+		},
+		{
+			TestName: "Rename collides with existing database",
+			Selection: RestoreSelection{
+				YSQLRenames: []RestoreRename{{BackupDatabase: "orders", RestoreDatabase: "yugabyte"}},
+			},
+			BackupDatabases:    backupDatabases,
+			TargetYSQL:         []string{"yugabyte"},
+			ExpectedAttributes: []string{"ysql_databases_rename"},
+		},
+		{
+			TestName: "Existing keyspace of the other API is not a collision",
+			Selection: RestoreSelection{
+				YCQLRenames: []RestoreRename{{BackupDatabase: "events", RestoreDatabase: "yugabyte"}},
+			},
+			BackupDatabases:    backupDatabases,
+			TargetYSQL:         []string{"yugabyte"},
+			ExpectedAttributes: nil,
+		},
+		{
+			// orders is a YSQL database, the backup only lists names so it passes as a keyspace
+			TestName: "Keyspace named like a database of the backup",
+			Selection: RestoreSelection{
+				YCQLKeyspaces: []string{"orders"},
+				YCQLRenames:   []RestoreRename{{BackupDatabase: "orders", RestoreDatabase: "orders_copy"}},
+			},
+			BackupDatabases:    backupDatabases,
+			TargetYSQL:         []string{"orders"},
+			ExpectedAttributes: nil,
+		},
+		{
+			TestName: "Two renames to the same name",
+			Selection: RestoreSelection{
+				YSQLRenames: []RestoreRename{
+					{BackupDatabase: "orders", RestoreDatabase: "archive"},
+					{BackupDatabase: "yugabyte", RestoreDatabase: "archive"},
+				},
+			},
+			BackupDatabases:    backupDatabases,
+			ExpectedAttributes: []string{"ysql_databases_rename"},
+		},
+		{
+			TestName: "Unknown backup contents",
+			Selection: RestoreSelection{
+				YSQLDatabases: []string{"anything"},
+				YSQLRenames:   []RestoreRename{{BackupDatabase: "anything", RestoreDatabase: "yugabyte"}},
+			},
+			BackupDatabases:    nil,
+			TargetYSQL:         []string{"yugabyte"},
+			ExpectedAttributes: []string{"ysql_databases_rename"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			errs := ValidateRestoreSelection(testCase.Selection, testCase.BackupDatabases, testCase.TargetYSQL, testCase.TargetYCQL)
+			var gotAttributes []string
+			for _, err := range errs {
+				gotAttributes = append(gotAttributes, err.Attribute)
+			}
+			if !AreListsEqual(gotAttributes, testCase.ExpectedAttributes) {
+				t.Errorf("ValidateRestoreSelection(...) reported %v; want %v", gotAttributes, testCase.ExpectedAttributes)
+			}
+		})
+	}
+}
+
+func TestIsRestoreVersionCompatible(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		BackupVersion    string
+		ClusterVersion   string
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "Same version",
+			BackupVersion:    "2.20.1.0-b97",
+			ClusterVersion:   "2.20.1.0-b97",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Newer cluster",
+			BackupVersion:    "2.18.7.0-b38",
+			ClusterVersion:   "2.20.1.0-b97",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Older cluster",
+			BackupVersion:    "2.20.1.0-b97",
+			ClusterVersion:   "2.18.7.0-b38",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Unknown backup version",
+			BackupVersion:    "",
+			ClusterVersion:   "2.18.7.0-b38",
+			ExpectedResponse: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotResponse, _ := IsRestoreVersionCompatible(testCase.BackupVersion, testCase.ClusterVersion)
+			if gotResponse != testCase.ExpectedResponse {
+				t.Errorf("IsRestoreVersionCompatible(%v, %v) = %v; want %v", testCase.BackupVersion, testCase.ClusterVersion, gotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestConnectionStrings(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		GotResponse      string
+		ExpectedResponse string
+	}{
+		{
+			TestName:         "YSQL URI with user",
+			GotResponse:      YSQLConnectionURI("us-west-2.example.cloud.yugabyte.com", "admin", "yugabyte", "root.crt"),
+			ExpectedResponse: "postgresql://admin@us-west-2.example.cloud.yugabyte.com:5433/yugabyte?sslmode=verify-full&sslrootcert=root.crt",
+		},
+		{
+			TestName:         "YSQL URI without user",
+			GotResponse:      YSQLConnectionURI("host", "", "yugabyte", "/home/me/root.crt"),
+			ExpectedResponse: "postgresql://host:5433/yugabyte?sslmode=verify-full&sslrootcert=%2Fhome%2Fme%2Froot.crt",
+		},
+		{
+			TestName:         "JDBC URL with user",
+			GotResponse:      JDBCConnectionURL("host", "admin", "yugabyte", "root.crt"),
+			ExpectedResponse: "jdbc:yugabytedb://host:5433/yugabyte?sslmode=verify-full&sslrootcert=root.crt&user=admin",
+		},
+		{
+			TestName:         "YCQL contact point",
+			GotResponse:      YCQLContactPoint("host"),
+			ExpectedResponse: "host:9042",
+		},
+		{
+			TestName:         "Smart driver parameters",
+			GotResponse:      SmartDriverLoadBalanceParams("AWS", "us-west-2", false),
+			ExpectedResponse: "load_balance=true&topology_keys=aws.us-west-2.*",
+		},
+		{
+			TestName:         "JDBC smart driver parameters",
+			GotResponse:      SmartDriverLoadBalanceParams("GCP", "us-east1", true),
+			ExpectedResponse: "load-balance=true&topology-keys=gcp.us-east1.*",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if testCase.GotResponse != testCase.ExpectedResponse {
+				t.Errorf("got %v; want %v", testCase.GotResponse, testCase.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestUpgradeListToMapByKey(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		RawState         string
+		ExpectedResponse string
+		ExpectError      bool
+	}{
+		{
+			TestName:         "Regions keyed by region code",
+			RawState:         `{"cluster_id":"id","cluster_region_info":[{"region":"us-west2","num_nodes":3},{"region":"us-east1","num_nodes":1}]}`,
+			ExpectedResponse: `{"cluster_id":"id","cluster_region_info":{"us-east1":{"num_nodes":1,"region":"us-east1"},"us-west2":{"num_nodes":3,"region":"us-west2"}}}`,
+		},
+		{
+			TestName:         "Large numbers kept as is",
+			RawState:         `{"cluster_region_info":[{"region":"us-west2","disk_iops":9007199254740993}]}`,
+			ExpectedResponse: `{"cluster_region_info":{"us-west2":{"disk_iops":9007199254740993,"region":"us-west2"}}}`,
+		},
+		{
+			TestName:         "Null list",
+			RawState:         `{"cluster_id":"id","cluster_region_info":null}`,
+			ExpectedResponse: `{"cluster_id":"id","cluster_region_info":null}`,
+		},
+		{
+			TestName:         "Missing list",
+			RawState:         `{"cluster_id":"id"}`,
+			ExpectedResponse: `{"cluster_id":"id"}`,
+		},
+		{
+			TestName:    "Duplicate region",
+			RawState:    `{"cluster_region_info":[{"region":"us-west2"},{"region":"us-west2"}]}`,
+			ExpectError: true,
+		},
+		{
+			TestName:    "Region missing",
+			RawState:    `{"cluster_region_info":[{"num_nodes":3}]}`,
+			ExpectError: true,
+		},
+		{
+			TestName:    "Invalid JSON",
+			RawState:    `{"cluster_region_info":`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			upgradedState, err := UpgradeListToMapByKey([]byte(testCase.RawState), "cluster_region_info", "region")
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error, got state %s", upgradedState)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(upgradedState) != testCase.ExpectedResponse {
+				t.Fatalf("Expected %s, got %s", testCase.ExpectedResponse, upgradedState)
+			}
+		})
+	}
+}
+
+func TestCMKValidators(t *testing.T) {
+	testCases := []struct {
+		TestName         string
+		Validator        func(string) bool
+		Input            string
+		ExpectedResponse bool
+	}{
+		{
+			TestName:         "AWS key ARN",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "arn:aws:kms:us-west-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "AWS alias ARN",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "arn:aws:kms:us-west-2:111122223333:alias/my-key",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "AWS GovCloud key ARN",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "arn:aws-us-gov:kms:us-gov-west-1:111122223333:key/1234abcd",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "AWS key ID instead of ARN",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "AWS ARN of another service",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "arn:aws:s3:::my-bucket",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "AWS ARN with short account",
+			Validator:        IsAWSKMSKeyARNValid,
+			Input:            "arn:aws:kms:us-west-2:1111:key/1234abcd",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "UUID",
+			Validator:        IsUUIDValid,
+			Input:            "8f3b6c3e-2d4a-4c4b-9b1e-0a6f1e2d3c4b",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "UUID upper case",
+			Validator:        IsUUIDValid,
+			Input:            "8F3B6C3E-2D4A-4C4B-9B1E-0A6F1E2D3C4B",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Not a UUID",
+			Validator:        IsUUIDValid,
+			Input:            "my-client",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Key vault URI",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://my-vault.vault.azure.net/",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Key vault URI without slash",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://my-vault.vault.azure.net",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Key vault URI in China",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://my-vault.vault.azure.cn/",
+			ExpectedResponse: true,
+		},
+		{
+			TestName:         "Key vault URI over http",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "http://my-vault.vault.azure.net/",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Key URI instead of key vault URI",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://my-vault.vault.azure.net/keys/my-key",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Key vault URI without vault name",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://.vault.azure.net/",
+			ExpectedResponse: false,
+		},
+		{
+			TestName:         "Not a key vault URI",
+			Validator:        IsAzureKeyVaultURIValid,
+			Input:            "https://example.com/",
+			ExpectedResponse: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if response := testCase.Validator(testCase.Input); response != testCase.ExpectedResponse {
+				t.Fatalf("Expected %v for %v, got %v", testCase.ExpectedResponse, testCase.Input, response)
+			}
+		})
+	}
+}
+
+func TestCMKKeyNames(t *testing.T) {
+	gcpKeyName := GCPKMSKeyName("my-project", "us-west1", "my-key-ring", "my-key")
+	if gcpKeyName != "projects/my-project/locations/us-west1/keyRings/my-key-ring/cryptoKeys/my-key" {
+		t.Fatalf("Unexpected GCP key name %v", gcpKeyName)
+	}
+	for _, keyVaultURI := range []string{"https://my-vault.vault.azure.net/", "https://my-vault.vault.azure.net"} {
+		azureKeyID := AzureKeyVaultKeyID(keyVaultURI, "my-key")
+		if azureKeyID != "https://my-vault.vault.azure.net/keys/my-key" {
+			t.Fatalf("Unexpected Azure key ID %v for %v", azureKeyID, keyVaultURI)
+		}
+	}
+}
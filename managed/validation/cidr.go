@@ -0,0 +1,116 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package validation
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// prefixLengthRange is the range of prefix lengths YugabyteDB Aeon accepts for the CIDR of a VPC
+// in a cloud. A lower prefix length is a larger range of addresses.
+type prefixLengthRange struct {
+	Min int
+	Max int
+}
+
+var vpcPrefixLengthRanges = map[string]prefixLengthRange{
+	"AWS":   {Min: 16, Max: 26},
+	"AZURE": {Min: 16, Max: 26},
+	"GCP":   {Min: 9, Max: 24},
+}
+
+// ParseCIDR parses an IPv4 or IPv6 CIDR. A plain address is read as a CIDR of that single address
+// when allowAddress is set.
+func ParseCIDR(cidr string, allowAddress bool) (*net.IPNet, error) {
+	cidr = strings.TrimSpace(cidr)
+	if allowAddress && !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return nil, fmt.Errorf("%q is neither an IP address nor a CIDR", cidr)
+		}
+		if ip.To4() != nil {
+			return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR, for example 10.0.0.0/16", cidr)
+	}
+	return ipNet, nil
+}
+
+// NormalizeCIDR returns the canonical notation of a CIDR or address: the network address without
+// host bits, the shortest IPv6 form and an explicit prefix length.
+func NormalizeCIDR(cidr string) (string, error) {
+	ipNet, err := ParseCIDR(cidr, true)
+	if err != nil {
+		return "", err
+	}
+	return ipNet.String(), nil
+}
+
+// EquivalentCIDRs reports whether two CIDRs or addresses cover the same range of addresses.
+func EquivalentCIDRs(cidr1 string, cidr2 string) bool {
+	normalized1, err1 := NormalizeCIDR(cidr1)
+	normalized2, err2 := NormalizeCIDR(cidr2)
+	return err1 == nil && err2 == nil && normalized1 == normalized2
+}
+
+// CIDRsOverlap reports whether two valid CIDRs have addresses in common.
+func CIDRsOverlap(cidr1 string, cidr2 string) bool {
+	ipNet1, err1 := ParseCIDR(cidr1, true)
+	ipNet2, err2 := ParseCIDR(cidr2, true)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	return ipNet1.Contains(ipNet2.IP) || ipNet2.Contains(ipNet1.IP)
+}
+
+// ValidateNetworkCIDR checks that a CIDR is a valid IPv4 network: the address must be the first
+// address of the range, as cloud providers reject CIDRs with host bits set.
+func ValidateNetworkCIDR(cidr string) error {
+	ipNet, err := ParseCIDR(cidr, false)
+	if err != nil {
+		return err
+	}
+	if ipNet.IP.To4() == nil {
+		return fmt.Errorf("%q is an IPv6 CIDR, only IPv4 CIDRs are supported", cidr)
+	}
+	if ipNet.String() != strings.TrimSpace(cidr) {
+		return fmt.Errorf("%q has host bits set, the network address of the range is %v", cidr, ipNet.String())
+	}
+	return nil
+}
+
+// ValidateVPCCIDR checks a CIDR of a YugabyteDB Aeon VPC, including the size limits of the cloud
+// of the VPC, whatever its case. The size limits are skipped when the cloud is unknown.
+func ValidateVPCCIDR(cloud string, cidr string) error {
+	if err := ValidateNetworkCIDR(cidr); err != nil {
+		return err
+	}
+	cloud = strings.ToUpper(cloud)
+	lengthRange, ok := vpcPrefixLengthRanges[cloud]
+	if !ok {
+		return nil
+	}
+	ipNet, _ := ParseCIDR(cidr, false)
+	prefixLength, _ := ipNet.Mask.Size()
+	if prefixLength > lengthRange.Max {
+		return fmt.Errorf("%q is too small, %v VPC CIDRs must be /%d or larger", cidr, cloud, lengthRange.Max)
+	}
+	if prefixLength < lengthRange.Min {
+		return fmt.Errorf("%q is too large, %v VPC CIDRs must be /%d or smaller", cidr, cloud, lengthRange.Min)
+	}
+	return nil
+}
+
+// ValidateAllowListCIDR checks an entry of an allow list, which is either a CIDR or a single address.
+func ValidateAllowListCIDR(cidr string) error {
+	_, err := ParseCIDR(cidr, true)
+	return err
+}
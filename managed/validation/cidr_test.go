@@ -0,0 +1,168 @@
+/*
+ * Copyright © 2022-present Yugabyte, Inc. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+package validation
+
+import (
+	"testing"
+)
+
+func TestNormalizeCIDR(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		CIDR           string
+		ExpectedCIDR   string
+		ExpectedErrors bool
+	}{
+		{
+			TestName:     "Canonical CIDR",
+			CIDR:         "10.0.0.0/16",
+			ExpectedCIDR: "10.0.0.0/16",
+		},
+		{
+			TestName:     "Host bits set",
+			CIDR:         "10.0.12.7/16",
+			ExpectedCIDR: "10.0.0.0/16",
+		},
+		{
+			TestName:     "Single IPv4 address",
+			CIDR:         "203.0.113.10",
+			ExpectedCIDR: "203.0.113.10/32",
+		},
+		{
+			TestName:     "Expanded IPv6 CIDR",
+			CIDR:         "2001:0db8:0000::/48",
+			ExpectedCIDR: "2001:db8::/48",
+		},
+		{
+			TestName:       "Not a CIDR",
+			CIDR:           "10.0.0.0/33",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "Hostname",
+			CIDR:           "example.com",
+			ExpectedErrors: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotCIDR, err := NormalizeCIDR(testCase.CIDR)
+			if (err != nil) != testCase.ExpectedErrors {
+				t.Errorf("NormalizeCIDR(%v) error = %v; want error %v", testCase.CIDR, err, testCase.ExpectedErrors)
+			}
+			if gotCIDR != testCase.ExpectedCIDR {
+				t.Errorf("NormalizeCIDR(%v) = %v; want %v", testCase.CIDR, gotCIDR, testCase.ExpectedCIDR)
+			}
+		})
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		CIDR1           string
+		CIDR2           string
+		ExpectedOverlap bool
+	}{
+		{
+			TestName:        "Disjoint ranges",
+			CIDR1:           "10.0.0.0/16",
+			CIDR2:           "10.1.0.0/16",
+			ExpectedOverlap: false,
+		},
+		{
+			TestName:        "Nested range",
+			CIDR1:           "10.0.0.0/16",
+			CIDR2:           "10.0.128.0/24",
+			ExpectedOverlap: true,
+		},
+		{
+			TestName:        "Enclosing range",
+			CIDR1:           "10.0.128.0/24",
+			CIDR2:           "10.0.0.0/8",
+			ExpectedOverlap: true,
+		},
+		{
+			TestName:        "Invalid CIDR",
+			CIDR1:           "10.0.0.0/16",
+			CIDR2:           "invalid",
+			ExpectedOverlap: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := CIDRsOverlap(testCase.CIDR1, testCase.CIDR2); got != testCase.ExpectedOverlap {
+				t.Errorf("CIDRsOverlap(%v,%v) = %v; want %v", testCase.CIDR1, testCase.CIDR2, got, testCase.ExpectedOverlap)
+			}
+		})
+	}
+}
+
+func TestValidateVPCCIDR(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		Cloud          string
+		CIDR           string
+		ExpectedErrors bool
+	}{
+		{
+			TestName: "AWS CIDR",
+			Cloud:    "AWS",
+			CIDR:     "10.0.0.0/24",
+		},
+		{
+			TestName:       "AWS CIDR too small",
+			Cloud:          "AWS",
+			CIDR:           "10.0.0.0/27",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "AWS CIDR too large",
+			Cloud:          "AWS",
+			CIDR:           "10.0.0.0/12",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "Lower case AWS CIDR too small",
+			Cloud:          "aws",
+			CIDR:           "10.0.0.0/27",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "GCP CIDR too small",
+			Cloud:          "GCP",
+			CIDR:           "10.0.0.0/26",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "Host bits set",
+			Cloud:          "GCP",
+			CIDR:           "10.0.0.1/24",
+			ExpectedErrors: true,
+		},
+		{
+			TestName:       "IPv6 CIDR",
+			Cloud:          "AWS",
+			CIDR:           "2001:db8::/48",
+			ExpectedErrors: true,
+		},
+		{
+			TestName: "Unknown cloud",
+			Cloud:    "",
+			CIDR:     "10.0.0.0/28",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := ValidateVPCCIDR(testCase.Cloud, testCase.CIDR)
+			if (err != nil) != testCase.ExpectedErrors {
+				t.Errorf("ValidateVPCCIDR(%v,%v) = %v; want error %v", testCase.Cloud, testCase.CIDR, err, testCase.ExpectedErrors)
+			}
+		})
+	}
+}
@@ -35,14 +35,13 @@ resource "ybm_cluster" "single_region" {
   cluster_name = "terraform-test-posriniv-2"
   cloud_type   = "GCP"
   cluster_type = "SYNCHRONOUS"
-  cluster_region_info = [
-    {
-      region    = "us-west2"
+  cluster_region_info = {
+    "us-west2" = {
       num_nodes = 1
       vpc_id    = ybm_vpc.newvpc.vpc_id
       num_cores = 2
     }
-  ]
+  }
   cluster_tier           = "PAID"
   cluster_allow_list_ids = [ybm_allow_list.mylist.allow_list_id]
   fault_tolerance        = "NONE"